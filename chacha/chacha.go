@@ -0,0 +1,132 @@
+// Package chacha provides generators based on the ChaCha stream cipher.
+// The generators yield the keystream of ChaCha as specified in RFC 8439, so their outputs are unpredictable as long
+// as the key is kept secret.
+//
+// Note that the internal state contains the key, so anyone who obtains the state can reproduce both past and future
+// outputs.
+package chacha
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	random32 "github.com/susisu/go-random/uint32"
+	random64 "github.com/susisu/go-random/uint64"
+)
+
+const (
+	blockWords = 16
+
+	counterIndex = 12
+	nonceIndex   = 13
+)
+
+// ChaCha is a generator that yields the ChaCha keystream.
+// Each 32-bit output is a word of the keystream, which is read in little-endian order.
+//
+// The block counter and the nonce together form a 128-bit counter; the nonce is incremented when the 32-bit block
+// counter wraps around, so the generator never repeats the keystream within 2^128 blocks.
+type ChaCha struct {
+	state  [blockWords]uint32
+	rounds int
+	buf    [blockWords]uint32
+	pos    int
+}
+
+var _ random32.Generator = (*ChaCha)(nil)
+var _ random64.Generator = (*ChaCha)(nil)
+
+// NewChaCha8 creates a new generator with the given key, based on ChaCha reduced to 8 rounds.
+// The nonce and the block counter are initialized to zero.
+func NewChaCha8(key [32]byte) *ChaCha {
+	return newChaCha(key, 8)
+}
+
+// NewChaCha20 creates a new generator with the given key, based on ChaCha with 20 rounds as specified in RFC 8439.
+// The nonce and the block counter are initialized to zero.
+func NewChaCha20(key [32]byte) *ChaCha {
+	return newChaCha(key, 20)
+}
+
+func newChaCha(key [32]byte, rounds int) *ChaCha {
+	c := &ChaCha{rounds: rounds, pos: blockWords}
+	// "expand 32-byte k"
+	c.state[0] = 0x61707865
+	c.state[1] = 0x3320646e
+	c.state[2] = 0x79622d32
+	c.state[3] = 0x6b206574
+	for i := 0; i < 8; i++ {
+		c.state[4+i] = binary.LittleEndian.Uint32(key[4*i:])
+	}
+	return c
+}
+
+// SetNonce sets the 96-bit nonce, discarding buffered values.
+func (c *ChaCha) SetNonce(nonce [12]byte) {
+	for i := 0; i < 3; i++ {
+		c.state[nonceIndex+i] = binary.LittleEndian.Uint32(nonce[4*i:])
+	}
+	c.pos = blockWords
+}
+
+// Seek positions the generator at the start of the block specified by counter, discarding buffered values.
+func (c *ChaCha) Seek(counter uint32) {
+	c.state[counterIndex] = counter
+	c.pos = blockWords
+}
+
+func quarterRound(a, b, c, d uint32) (uint32, uint32, uint32, uint32) {
+	a += b
+	d = bits.RotateLeft32(d^a, 16)
+	c += d
+	b = bits.RotateLeft32(b^c, 12)
+	a += b
+	d = bits.RotateLeft32(d^a, 8)
+	c += d
+	b = bits.RotateLeft32(b^c, 7)
+	return a, b, c, d
+}
+
+func (c *ChaCha) next() {
+	x := c.state
+	for i := 0; i < c.rounds; i += 2 {
+		// column rounds
+		x[0], x[4], x[8], x[12] = quarterRound(x[0], x[4], x[8], x[12])
+		x[1], x[5], x[9], x[13] = quarterRound(x[1], x[5], x[9], x[13])
+		x[2], x[6], x[10], x[14] = quarterRound(x[2], x[6], x[10], x[14])
+		x[3], x[7], x[11], x[15] = quarterRound(x[3], x[7], x[11], x[15])
+		// diagonal rounds
+		x[0], x[5], x[10], x[15] = quarterRound(x[0], x[5], x[10], x[15])
+		x[1], x[6], x[11], x[12] = quarterRound(x[1], x[6], x[11], x[12])
+		x[2], x[7], x[8], x[13] = quarterRound(x[2], x[7], x[8], x[13])
+		x[3], x[4], x[9], x[14] = quarterRound(x[3], x[4], x[9], x[14])
+	}
+	for i := range x {
+		c.buf[i] = x[i] + c.state[i]
+	}
+	c.pos = 0
+	for i := counterIndex; i < blockWords; i++ {
+		c.state[i]++
+		if c.state[i] != 0 {
+			break
+		}
+	}
+}
+
+// Uint32 returns a random uint32 value.
+func (c *ChaCha) Uint32() uint32 {
+	if c.pos == blockWords {
+		c.next()
+	}
+	v := c.buf[c.pos]
+	c.pos++
+	return v
+}
+
+// Uint64 returns a random uint64 value by combining two outputs, the lower half first.
+// This is equivalent to reading eight bytes of the keystream in little-endian order.
+func (c *ChaCha) Uint64() uint64 {
+	lo := uint64(c.Uint32())
+	hi := uint64(c.Uint32())
+	return (hi << 32) | lo
+}
@@ -0,0 +1,120 @@
+package chacha_test
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"testing"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+	"github.com/susisu/go-random/chacha"
+)
+
+func TestMain(t *testing.M) {
+	v := t.Run()
+	snaps.Clean(t)
+	os.Exit(v)
+}
+
+func keystream(c *chacha.ChaCha, n int) string {
+	b := make([]byte, n)
+	for i := 0; i < n; i += 4 {
+		binary.LittleEndian.PutUint32(b[i:], c.Uint32())
+	}
+	return hex.EncodeToString(b)
+}
+
+func sequentialKey() [32]byte {
+	var key [32]byte
+	for i := range key {
+		key[i] = byte(i)
+	}
+	return key
+}
+
+func TestChaCha20(t *testing.T) {
+	t.Run("RFC 8439 2.3.2 block function", func(t *testing.T) {
+		c := chacha.NewChaCha20(sequentialKey())
+		c.SetNonce([12]byte{0, 0, 0, 0x09, 0, 0, 0, 0x4a, 0, 0, 0, 0})
+		c.Seek(1)
+		assert.Equal(t,
+			"10f1e7e4d13b5915500fdd1fa32071c4c7d1f4c733c068030422aa9ac3d46c4e"+
+				"d2826446079faa0914c2d705d98b02a2b5129cd1de164eb9cbd083e8a2503c4e",
+			keystream(c, 64),
+		)
+	})
+
+	t.Run("RFC 8439 2.4.2 keystream", func(t *testing.T) {
+		c := chacha.NewChaCha20(sequentialKey())
+		c.SetNonce([12]byte{0, 0, 0, 0, 0, 0, 0, 0x4a, 0, 0, 0, 0})
+		c.Seek(1)
+		assert.Equal(t,
+			"224f51f3401bd9e12fde276fb8631ded8c131f823d2c06e27e4fcaec9ef3cf78"+
+				"8a3b0aa372600a92b57974cded2b9334794cba40c63e34cdea212c4cf07d41b7"+
+				"69a6749f3f630f4122cafe28ec4dc47e26d4346d70b98c73f3e9c53ac40c5945"+
+				"398b6eda1a832c89c167eacd901d7e2bf363740373201aa188fbbce83991c4ed",
+			keystream(c, 128),
+		)
+	})
+
+	t.Run("RFC 8439 A.1 test vectors #1 and #2", func(t *testing.T) {
+		c := chacha.NewChaCha20([32]byte{})
+		assert.Equal(t,
+			"76b8e0ada0f13d90405d6ae55386bd28bdd219b8a08ded1aa836efcc8b770dc7"+
+				"da41597c5157488d7724e03fb8d84a376a43b8f41518a11cc387b669b2ee6586"+
+				"9f07e7be5551387a98ba977c732d080dcb0f29a048e3656912c6533e32ee7aed"+
+				"29b721769ce64e43d57133b074d839d531ed1f28510afb45ace10a1f4b794d6f",
+			keystream(c, 128),
+		)
+	})
+
+	t.Run("Uint64 reads eight bytes in little-endian order", func(t *testing.T) {
+		c := chacha.NewChaCha20([32]byte{})
+		assert.Equal(t, uint64(0x903df1a0ade0b876), c.Uint64())
+		assert.Equal(t, uint64(0x28bd8653e56a5d40), c.Uint64())
+	})
+
+	t.Run("block counter carries over to the nonce", func(t *testing.T) {
+		c1 := chacha.NewChaCha20(sequentialKey())
+		c1.SetNonce([12]byte{0, 0, 0, 0, 0, 0, 0, 0x4a, 0, 0, 0, 0})
+		c1.Seek(0xffffffff)
+		keystream(c1, 64)
+
+		c2 := chacha.NewChaCha20(sequentialKey())
+		c2.SetNonce([12]byte{1, 0, 0, 0, 0, 0, 0, 0x4a, 0, 0, 0, 0})
+		assert.Equal(t, keystream(c2, 64), keystream(c1, 64))
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		c := chacha.NewChaCha20([32]byte{0xc0, 0xff, 0xee})
+		numSamples := 100
+		seq := make([]uint64, 0, numSamples)
+		for i := 0; i < numSamples; i++ {
+			seq = append(seq, c.Uint64())
+		}
+		snaps.MatchSnapshot(t, seq)
+	})
+}
+
+func TestChaCha8(t *testing.T) {
+	t.Run("reference", func(t *testing.T) {
+		// draft-strombergson-chacha-test-vectors, TC1 with 8 rounds and 256-bit key
+		c := chacha.NewChaCha8([32]byte{})
+		assert.Equal(t,
+			"3e00ef2f895f40d67f5bb8e81f09a5a12c840ec3ce9a7f3b181be188ef711a1e"+
+				"984ce172b9216f419f445367456d5619314a42a3da86b001387bfdb80e0cfe42",
+			keystream(c, 64),
+		)
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		c := chacha.NewChaCha8([32]byte{0xc0, 0xff, 0xee})
+		numSamples := 100
+		seq := make([]uint64, 0, numSamples)
+		for i := 0; i < numSamples; i++ {
+			seq = append(seq, c.Uint64())
+		}
+		snaps.MatchSnapshot(t, seq)
+	})
+}
@@ -0,0 +1,122 @@
+// Package mt provides the Mersenne Twister generators MT19937 and MT19937-64.
+// They reproduce the sequences of the reference implementations (mt19937ar.c and mt19937-64.c), which are also
+// produced by std::mt19937 and std::mt19937_64 of C++ and by the legacy RandomState of NumPy.
+package mt
+
+import (
+	random32 "github.com/susisu/go-random/uint32"
+	random64 "github.com/susisu/go-random/uint64"
+)
+
+const (
+	n32       = 624
+	m32       = 397
+	matrixA32 = 0x9908b0df
+	upper32   = 0x80000000
+	lower32   = 0x7fffffff
+)
+
+// MT19937 is the 32-bit Mersenne Twister.
+type MT19937 struct {
+	mt  [n32]uint32
+	mti int
+}
+
+var _ random32.Generator = (*MT19937)(nil)
+var _ random64.Generator = (*MT19937)(nil)
+
+// NewMT19937 creates a new MT19937 generator initialized with the given seed, in the same way as init_genrand.
+// The default seed of std::mt19937 is 5489.
+func NewMT19937(seed uint32) *MT19937 {
+	g := &MT19937{}
+	g.Seed(seed)
+	return g
+}
+
+// Seed initializes the generator with the given seed, in the same way as init_genrand.
+// NumPy's legacy RandomState uses this when seeded with an integer.
+func (g *MT19937) Seed(seed uint32) {
+	g.mt[0] = seed
+	for i := 1; i < n32; i++ {
+		g.mt[i] = 1812433253*(g.mt[i-1]^(g.mt[i-1]>>30)) + uint32(i)
+	}
+	g.mti = n32
+}
+
+// SeedByArray initializes the generator with the given array of seeds, in the same way as init_by_array.
+// It panics if key is empty.
+// NumPy's legacy RandomState uses this when seeded with an array.
+func (g *MT19937) SeedByArray(key []uint32) {
+	if len(key) == 0 {
+		panic("invalid argument to SeedByArray: key must not be empty")
+	}
+	g.Seed(19650218)
+	i, j := 1, 0
+	k := n32
+	if len(key) > k {
+		k = len(key)
+	}
+	for ; k > 0; k-- {
+		g.mt[i] = (g.mt[i] ^ ((g.mt[i-1] ^ (g.mt[i-1] >> 30)) * 1664525)) + key[j] + uint32(j)
+		i++
+		j++
+		if i >= n32 {
+			g.mt[0] = g.mt[n32-1]
+			i = 1
+		}
+		if j >= len(key) {
+			j = 0
+		}
+	}
+	for k = n32 - 1; k > 0; k-- {
+		g.mt[i] = (g.mt[i] ^ ((g.mt[i-1] ^ (g.mt[i-1] >> 30)) * 1566083941)) - uint32(i)
+		i++
+		if i >= n32 {
+			g.mt[0] = g.mt[n32-1]
+			i = 1
+		}
+	}
+	g.mt[0] = 0x80000000
+}
+
+func (g *MT19937) twist() {
+	for i := 0; i < n32; i++ {
+		y := (g.mt[i] & upper32) | (g.mt[(i+1)%n32] & lower32)
+		v := g.mt[(i+m32)%n32] ^ (y >> 1)
+		if y&1 != 0 {
+			v ^= matrixA32
+		}
+		g.mt[i] = v
+	}
+	g.mti = 0
+}
+
+// Uint32 returns a random uint32 value, in the same way as genrand_int32.
+func (g *MT19937) Uint32() uint32 {
+	if g.mti >= n32 {
+		g.twist()
+	}
+	y := g.mt[g.mti]
+	g.mti++
+	y ^= y >> 11
+	y ^= (y << 7) & 0x9d2c5680
+	y ^= (y << 15) & 0xefc60000
+	y ^= y >> 18
+	return y
+}
+
+// Uint64 returns a random uint64 value by combining two outputs, the lower half first.
+func (g *MT19937) Uint64() uint64 {
+	lo := uint64(g.Uint32())
+	hi := uint64(g.Uint32())
+	return (hi << 32) | lo
+}
+
+// Res53 returns a random float64 value within the range [0, 1) with 53-bit resolution, in the same way as
+// genrand_res53.
+// NumPy's legacy RandomState uses this for random_sample.
+func (g *MT19937) Res53() float64 {
+	a := g.Uint32() >> 5
+	b := g.Uint32() >> 6
+	return (float64(a)*67108864.0 + float64(b)) * (1.0 / 9007199254740992.0)
+}
@@ -0,0 +1,107 @@
+package mt
+
+import (
+	random32 "github.com/susisu/go-random/uint32"
+	random64 "github.com/susisu/go-random/uint64"
+)
+
+const (
+	n64       = 312
+	m64       = 156
+	matrixA64 = 0xb5026f5aa96619e9
+	upper64   = 0xffffffff80000000
+	lower64   = 0x7fffffff
+)
+
+// MT19937_64 is the 64-bit Mersenne Twister.
+type MT19937_64 struct {
+	mt  [n64]uint64
+	mti int
+}
+
+var _ random32.Generator = (*MT19937_64)(nil)
+var _ random64.Generator = (*MT19937_64)(nil)
+
+// NewMT19937_64 creates a new MT19937-64 generator initialized with the given seed, in the same way as
+// init_genrand64.
+// The default seed of std::mt19937_64 is 5489.
+func NewMT19937_64(seed uint64) *MT19937_64 {
+	g := &MT19937_64{}
+	g.Seed(seed)
+	return g
+}
+
+// Seed initializes the generator with the given seed, in the same way as init_genrand64.
+func (g *MT19937_64) Seed(seed uint64) {
+	g.mt[0] = seed
+	for i := 1; i < n64; i++ {
+		g.mt[i] = 6364136223846793005*(g.mt[i-1]^(g.mt[i-1]>>62)) + uint64(i)
+	}
+	g.mti = n64
+}
+
+// SeedByArray initializes the generator with the given array of seeds, in the same way as init_by_array64.
+// It panics if key is empty.
+func (g *MT19937_64) SeedByArray(key []uint64) {
+	if len(key) == 0 {
+		panic("invalid argument to SeedByArray: key must not be empty")
+	}
+	g.Seed(19650218)
+	i, j := 1, 0
+	k := n64
+	if len(key) > k {
+		k = len(key)
+	}
+	for ; k > 0; k-- {
+		g.mt[i] = (g.mt[i] ^ ((g.mt[i-1] ^ (g.mt[i-1] >> 62)) * 3935559000370003845)) + key[j] + uint64(j)
+		i++
+		j++
+		if i >= n64 {
+			g.mt[0] = g.mt[n64-1]
+			i = 1
+		}
+		if j >= len(key) {
+			j = 0
+		}
+	}
+	for k = n64 - 1; k > 0; k-- {
+		g.mt[i] = (g.mt[i] ^ ((g.mt[i-1] ^ (g.mt[i-1] >> 62)) * 2862933555777941757)) - uint64(i)
+		i++
+		if i >= n64 {
+			g.mt[0] = g.mt[n64-1]
+			i = 1
+		}
+	}
+	g.mt[0] = 1 << 63
+}
+
+func (g *MT19937_64) twist() {
+	for i := 0; i < n64; i++ {
+		y := (g.mt[i] & upper64) | (g.mt[(i+1)%n64] & lower64)
+		v := g.mt[(i+m64)%n64] ^ (y >> 1)
+		if y&1 != 0 {
+			v ^= matrixA64
+		}
+		g.mt[i] = v
+	}
+	g.mti = 0
+}
+
+// Uint64 returns a random uint64 value, in the same way as genrand64_int64.
+func (g *MT19937_64) Uint64() uint64 {
+	if g.mti >= n64 {
+		g.twist()
+	}
+	x := g.mt[g.mti]
+	g.mti++
+	x ^= (x >> 29) & 0x5555555555555555
+	x ^= (x << 17) & 0x71d67fffeda60000
+	x ^= (x << 37) & 0xfff7eee000000000
+	x ^= x >> 43
+	return x
+}
+
+// Uint32 returns a random uint32 value taken from the upper half of a 64-bit output.
+func (g *MT19937_64) Uint32() uint32 {
+	return uint32(g.Uint64() >> 32)
+}
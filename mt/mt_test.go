@@ -0,0 +1,117 @@
+package mt_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+	"github.com/susisu/go-random/mt"
+)
+
+func TestMain(t *testing.M) {
+	v := t.Run()
+	snaps.Clean(t)
+	os.Exit(v)
+}
+
+func testSnapshot[T any](t *testing.T, generate func() T) {
+	numSamples := 100
+
+	seq := make([]T, 0, numSamples)
+	for i := 0; i < numSamples; i++ {
+		seq = append(seq, generate())
+	}
+
+	snaps.MatchSnapshot(t, seq)
+}
+
+func TestMT19937(t *testing.T) {
+	t.Run("init_genrand", func(t *testing.T) {
+		// std::mt19937 with the default seed
+		g := mt.NewMT19937(5489)
+		assert.Equal(t, uint32(3499211612), g.Uint32())
+		for i := 1; i < 9999; i++ {
+			g.Uint32()
+		}
+		assert.Equal(t, uint32(4123659995), g.Uint32())
+	})
+
+	t.Run("SeedByArray panics if key is empty", func(t *testing.T) {
+		g := mt.NewMT19937(0)
+		assert.Panics(t, func() { g.SeedByArray(nil) })
+		assert.Panics(t, func() { g.SeedByArray([]uint32{}) })
+	})
+
+	t.Run("init_by_array", func(t *testing.T) {
+		// mt19937ar.out of the reference implementation
+		g := mt.NewMT19937(0)
+		g.SeedByArray([]uint32{0x123, 0x234, 0x345, 0x456})
+		expected := []uint32{1067595299, 955945823, 477289528, 4107218783, 4228976476}
+		for i, e := range expected {
+			assert.Equalf(t, e, g.Uint32(), "output %d", i)
+		}
+	})
+
+	t.Run("Seed resets the state", func(t *testing.T) {
+		g := mt.NewMT19937(0)
+		g.Uint32()
+		g.Seed(5489)
+		assert.Equal(t, uint32(3499211612), g.Uint32())
+	})
+
+	t.Run("Res53 combines two outputs", func(t *testing.T) {
+		g1 := mt.NewMT19937(42)
+		g2 := mt.NewMT19937(42)
+		a := g2.Uint32() >> 5
+		b := g2.Uint32() >> 6
+		assert.Equal(t, (float64(a)*(1<<26)+float64(b))/(1<<53), g1.Res53())
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		g := mt.NewMT19937(0xc0ffee)
+		testSnapshot(t, g.Uint32)
+	})
+}
+
+func TestMT19937_64(t *testing.T) {
+	t.Run("init_genrand64", func(t *testing.T) {
+		// std::mt19937_64 with the default seed
+		g := mt.NewMT19937_64(5489)
+		for i := 0; i < 9999; i++ {
+			g.Uint64()
+		}
+		assert.Equal(t, uint64(9981545732273789042), g.Uint64())
+	})
+
+	t.Run("SeedByArray panics if key is empty", func(t *testing.T) {
+		g := mt.NewMT19937_64(0)
+		assert.Panics(t, func() { g.SeedByArray(nil) })
+		assert.Panics(t, func() { g.SeedByArray([]uint64{}) })
+	})
+
+	t.Run("init_by_array64", func(t *testing.T) {
+		// mt19937-64.out of the reference implementation
+		g := mt.NewMT19937_64(0)
+		g.SeedByArray([]uint64{0x12345, 0x23456, 0x34567, 0x45678})
+		expected := []uint64{
+			7266447313870364031, 4946485549665804864, 16945909448695747420, 16394063075524226720, 4873882236456199058,
+		}
+		for i, e := range expected {
+			assert.Equalf(t, e, g.Uint64(), "output %d", i)
+		}
+	})
+
+	t.Run("Seed resets the state", func(t *testing.T) {
+		g1 := mt.NewMT19937_64(5489)
+		g2 := mt.NewMT19937_64(0)
+		g2.Uint64()
+		g2.Seed(5489)
+		assert.Equal(t, g1.Uint64(), g2.Uint64())
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		g := mt.NewMT19937_64(0xc0ffee)
+		testSnapshot(t, g.Uint64)
+	})
+}
@@ -0,0 +1,174 @@
+// Package pcg provides generators of the PCG family.
+// See https://www.pcg-random.org/ for details of the algorithms.
+package pcg
+
+import (
+	"math/bits"
+
+	random32 "github.com/susisu/go-random/uint32"
+	random64 "github.com/susisu/go-random/uint64"
+)
+
+const (
+	mul64 = 6364136223846793005
+
+	mul128Hi = 2549297995355413924
+	mul128Lo = 4865540595714422341
+
+	cheapMul128 = 0xda942042e4dd58b5
+)
+
+// PCG32 is a PCG generator with 64-bit state and 32-bit output (PCG-XSH-RR).
+// It produces the same sequence as pcg32_random_r of the reference C implementation.
+type PCG32 struct {
+	state uint64
+	inc   uint64
+}
+
+var _ random32.Generator = (*PCG32)(nil)
+var _ random64.Generator = (*PCG32)(nil)
+
+// NewPCG32 creates a new PCG32 generator initialized with the given seed and stream.
+func NewPCG32(seed, stream uint64) *PCG32 {
+	p := &PCG32{}
+	p.Seed(seed, stream)
+	return p
+}
+
+// Seed initializes the generator with the given seed and stream, in the same way as pcg32_srandom_r.
+func (p *PCG32) Seed(seed, stream uint64) {
+	p.state = 0
+	p.inc = (stream << 1) | 1
+	p.step()
+	p.state += seed
+	p.step()
+}
+
+func (p *PCG32) step() {
+	p.state = p.state*mul64 + p.inc
+}
+
+// Uint32 returns a random uint32 value.
+func (p *PCG32) Uint32() uint32 {
+	old := p.state
+	p.step()
+	xorshifted := uint32(((old >> 18) ^ old) >> 27)
+	rot := int(old >> 59)
+	return bits.RotateLeft32(xorshifted, -rot)
+}
+
+// Uint64 returns a random uint64 value by combining two outputs, the lower half first.
+func (p *PCG32) Uint64() uint64 {
+	lo := uint64(p.Uint32())
+	hi := uint64(p.Uint32())
+	return (hi << 32) | lo
+}
+
+// state128 is a 128-bit LCG state shared by the 64-bit output variants.
+type state128 struct {
+	hi, lo       uint64
+	incHi, incLo uint64
+}
+
+func (s *state128) seed(seedHi, seedLo, streamHi, streamLo uint64, step func()) {
+	s.hi, s.lo = 0, 0
+	s.incHi = (streamHi << 1) | (streamLo >> 63)
+	s.incLo = (streamLo << 1) | 1
+	step()
+	var c uint64
+	s.lo, c = bits.Add64(s.lo, seedLo, 0)
+	s.hi, _ = bits.Add64(s.hi, seedHi, c)
+	step()
+}
+
+// step advances the state using the default 128-bit multiplier.
+func (s *state128) step() {
+	hi, lo := bits.Mul64(s.lo, mul128Lo)
+	hi += s.hi*mul128Lo + s.lo*mul128Hi
+	var c uint64
+	s.lo, c = bits.Add64(lo, s.incLo, 0)
+	s.hi, _ = bits.Add64(hi, s.incHi, c)
+}
+
+// cheapStep advances the state using the 64-bit "cheap" multiplier.
+func (s *state128) cheapStep() {
+	hi, lo := bits.Mul64(s.lo, cheapMul128)
+	hi += s.hi * cheapMul128
+	var c uint64
+	s.lo, c = bits.Add64(lo, s.incLo, 0)
+	s.hi, _ = bits.Add64(hi, s.incHi, c)
+}
+
+// PCG64 is a PCG generator with 128-bit state and 64-bit output (PCG-XSL-RR).
+// It produces the same sequence as pcg64_random_r of the reference C implementation,
+// and as PCG64 of NumPy.
+type PCG64 struct {
+	s state128
+}
+
+var _ random32.Generator = (*PCG64)(nil)
+var _ random64.Generator = (*PCG64)(nil)
+
+// NewPCG64 creates a new PCG64 generator initialized with the given 128-bit seed and stream.
+func NewPCG64(seedHi, seedLo, streamHi, streamLo uint64) *PCG64 {
+	p := &PCG64{}
+	p.Seed(seedHi, seedLo, streamHi, streamLo)
+	return p
+}
+
+// Seed initializes the generator with the given 128-bit seed and stream, in the same way as pcg64_srandom_r.
+func (p *PCG64) Seed(seedHi, seedLo, streamHi, streamLo uint64) {
+	p.s.seed(seedHi, seedLo, streamHi, streamLo, p.s.step)
+}
+
+// Uint64 returns a random uint64 value.
+func (p *PCG64) Uint64() uint64 {
+	p.s.step()
+	rot := int(p.s.hi >> 58)
+	return bits.RotateLeft64(p.s.hi^p.s.lo, -rot)
+}
+
+// Uint32 returns a random uint32 value taken from the upper half of a 64-bit output.
+func (p *PCG64) Uint32() uint32 {
+	return uint32(p.Uint64() >> 32)
+}
+
+// PCG64DXSM is a PCG generator with 128-bit state and 64-bit output (PCG-DXSM),
+// which uses the "cheap" 64-bit multiplier and outputs from the state before advancing.
+// It produces the same sequence as cm_setseq_dxsm_128_64 of the reference C++ implementation,
+// and as PCG64DXSM of NumPy.
+type PCG64DXSM struct {
+	s state128
+}
+
+var _ random32.Generator = (*PCG64DXSM)(nil)
+var _ random64.Generator = (*PCG64DXSM)(nil)
+
+// NewPCG64DXSM creates a new PCG64DXSM generator initialized with the given 128-bit seed and stream.
+func NewPCG64DXSM(seedHi, seedLo, streamHi, streamLo uint64) *PCG64DXSM {
+	p := &PCG64DXSM{}
+	p.Seed(seedHi, seedLo, streamHi, streamLo)
+	return p
+}
+
+// Seed initializes the generator with the given 128-bit seed and stream.
+func (p *PCG64DXSM) Seed(seedHi, seedLo, streamHi, streamLo uint64) {
+	p.s.seed(seedHi, seedLo, streamHi, streamLo, p.s.cheapStep)
+}
+
+// Uint64 returns a random uint64 value.
+func (p *PCG64DXSM) Uint64() uint64 {
+	hi := p.s.hi
+	lo := p.s.lo | 1
+	p.s.cheapStep()
+	hi ^= hi >> 32
+	hi *= cheapMul128
+	hi ^= hi >> 48
+	hi *= lo
+	return hi
+}
+
+// Uint32 returns a random uint32 value taken from the upper half of a 64-bit output.
+func (p *PCG64DXSM) Uint32() uint32 {
+	return uint32(p.Uint64() >> 32)
+}
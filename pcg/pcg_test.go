@@ -0,0 +1,132 @@
+package pcg_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+	"github.com/susisu/go-random/pcg"
+)
+
+func TestMain(t *testing.M) {
+	v := t.Run()
+	snaps.Clean(t)
+	os.Exit(v)
+}
+
+func testSnapshot[T any](t *testing.T, generate func() T) {
+	numSamples := 100
+
+	seq := make([]T, 0, numSamples)
+	for i := 0; i < numSamples; i++ {
+		seq = append(seq, generate())
+	}
+
+	snaps.MatchSnapshot(t, seq)
+}
+
+func TestPCG32(t *testing.T) {
+	t.Run("reference", func(t *testing.T) {
+		// pcg32-demo of the reference C implementation
+		g := pcg.NewPCG32(42, 54)
+		expected := []uint32{
+			0xa15c02b7, 0x7b47f409, 0xba1d3330, 0x83d2f293, 0xbfa4784b, 0xcbed606e, 0xbfc6a3ad, 0x812fff6d,
+		}
+		for i, e := range expected {
+			assert.Equalf(t, e, g.Uint32(), "output %d", i)
+		}
+	})
+
+	t.Run("Uint64 combines two outputs", func(t *testing.T) {
+		g1 := pcg.NewPCG32(42, 54)
+		g2 := pcg.NewPCG32(42, 54)
+		for i := 0; i < 10; i++ {
+			lo := uint64(g1.Uint32())
+			hi := uint64(g1.Uint32())
+			assert.Equal(t, (hi<<32)|lo, g2.Uint64())
+		}
+	})
+
+	t.Run("Seed resets the state", func(t *testing.T) {
+		g := pcg.NewPCG32(42, 54)
+		g.Uint32()
+		g.Seed(42, 54)
+		assert.Equal(t, uint32(0xa15c02b7), g.Uint32())
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		g := pcg.NewPCG32(0xc0ffee, 0)
+		testSnapshot(t, g.Uint32)
+	})
+}
+
+func TestPCG64(t *testing.T) {
+	t.Run("reference", func(t *testing.T) {
+		// pcg64-demo of the reference C implementation
+		g := pcg.NewPCG64(0, 42, 0, 54)
+		expected := []uint64{
+			0x86b1da1d72062b68, 0x1304aa46c9853d39, 0xa3670e9e0dd50358, 0xf9090e529a7dae00,
+			0xc85b9fd837996f2c, 0x606121f8e3919196, 0x7ce1c7ff478354ba, 0xcbc4ac70e541310e,
+		}
+		for i, e := range expected {
+			assert.Equalf(t, e, g.Uint64(), "output %d", i)
+		}
+	})
+
+	t.Run("reference (128-bit seed and stream)", func(t *testing.T) {
+		g := pcg.NewPCG64(0x0123456789abcdef, 0xfedcba9876543210, 0x0f1e2d3c4b5a6978, 0x8796a5b4c3d2e1f0)
+		expected := []uint64{
+			0x4d1ac2c001800c11, 0x171ab1db52f8ca2f, 0x8b5d0841f9d3c460, 0xe52531dae61eccc4,
+			0xbabd1227b58b0717, 0x7fb5c2e6502f792f, 0x9379b72ebfa98d3b, 0xfda0466bf8cffb88,
+		}
+		for i, e := range expected {
+			assert.Equalf(t, e, g.Uint64(), "output %d", i)
+		}
+	})
+
+	t.Run("Uint32 takes the upper half", func(t *testing.T) {
+		g := pcg.NewPCG64(0, 42, 0, 54)
+		assert.Equal(t, uint32(0x86b1da1d), g.Uint32())
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		g := pcg.NewPCG64(0, 0xc0ffee, 0, 0)
+		testSnapshot(t, g.Uint64)
+	})
+}
+
+func TestPCG64DXSM(t *testing.T) {
+	t.Run("reference", func(t *testing.T) {
+		// cm_setseq_dxsm_128_64 of the reference C++ implementation
+		g := pcg.NewPCG64DXSM(0, 42, 0, 54)
+		expected := []uint64{
+			0xf0847c9518bddb90, 0x8e7d5f5514ba8aaa, 0x86fbd36f8028f6fd, 0x8d14b6edbe9f740a,
+			0xa85b2896c7cad55d, 0x8ca3894a1d9227bb, 0x9f804d5db108f5df, 0xb0dcd9c3191b2a32,
+		}
+		for i, e := range expected {
+			assert.Equalf(t, e, g.Uint64(), "output %d", i)
+		}
+	})
+
+	t.Run("reference (128-bit seed and stream)", func(t *testing.T) {
+		g := pcg.NewPCG64DXSM(0x0123456789abcdef, 0xfedcba9876543210, 0x0f1e2d3c4b5a6978, 0x8796a5b4c3d2e1f0)
+		expected := []uint64{
+			0xcc7c5b60ecb366d1, 0xc77c92f1acaf7c5d, 0x6675b59001d48e92, 0x71a2d9b96773a509,
+			0x0cd31d49c9d7a467, 0x905580c279ead150, 0xf911d8777adfefb5, 0x44821800848b6206,
+		}
+		for i, e := range expected {
+			assert.Equalf(t, e, g.Uint64(), "output %d", i)
+		}
+	})
+
+	t.Run("Uint32 takes the upper half", func(t *testing.T) {
+		g := pcg.NewPCG64DXSM(0, 42, 0, 54)
+		assert.Equal(t, uint32(0xf0847c95), g.Uint32())
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		g := pcg.NewPCG64DXSM(0, 0xc0ffee, 0, 0)
+		testSnapshot(t, g.Uint64)
+	})
+}
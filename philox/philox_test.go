@@ -0,0 +1,102 @@
+package philox_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+	"github.com/susisu/go-random/philox"
+)
+
+func TestMain(t *testing.M) {
+	v := t.Run()
+	snaps.Clean(t)
+	os.Exit(v)
+}
+
+func TestBlock4x32(t *testing.T) {
+	// known-answer tests of the reference Random123 implementation
+	t.Run("zeros", func(t *testing.T) {
+		v := philox.Block4x32([4]uint32{}, [2]uint32{})
+		assert.Equal(t, [4]uint32{0x6627e8d5, 0xe169c58d, 0xbc57ac4c, 0x9b00dbd8}, v)
+	})
+
+	t.Run("ones", func(t *testing.T) {
+		v := philox.Block4x32(
+			[4]uint32{0xffffffff, 0xffffffff, 0xffffffff, 0xffffffff},
+			[2]uint32{0xffffffff, 0xffffffff},
+		)
+		assert.Equal(t, [4]uint32{0x408f276d, 0x41c83b0e, 0xa20bc7c6, 0x6d5451fd}, v)
+	})
+
+	t.Run("pi", func(t *testing.T) {
+		v := philox.Block4x32(
+			[4]uint32{0x243f6a88, 0x85a308d3, 0x13198a2e, 0x03707344},
+			[2]uint32{0xa4093822, 0x299f31d0},
+		)
+		assert.Equal(t, [4]uint32{0xd16cfe09, 0x94fdcceb, 0x5001e420, 0x24126ea1}, v)
+	})
+}
+
+func TestPhilox4x32(t *testing.T) {
+	key := uint64(0x299f31d0a4093822)
+
+	t.Run("yields blocks of consecutive counters", func(t *testing.T) {
+		g := philox.NewPhilox4x32(key)
+		for c := uint32(0); c < 3; c++ {
+			block := philox.Block4x32([4]uint32{c, 0, 0, 0}, [2]uint32{0xa4093822, 0x299f31d0})
+			for i, e := range block {
+				assert.Equalf(t, e, g.Uint32(), "block %d, word %d", c, i)
+			}
+		}
+	})
+
+	t.Run("Seek positions at the start of the block", func(t *testing.T) {
+		g := philox.NewPhilox4x32(key)
+		g.Uint32()
+		g.Seek(0x123456789)
+		block := philox.Block4x32([4]uint32{0x23456789, 0x1, 0, 0}, [2]uint32{0xa4093822, 0x299f31d0})
+		for i, e := range block {
+			assert.Equalf(t, e, g.Uint32(), "word %d", i)
+		}
+	})
+
+	t.Run("Seek gives the same values for the same counter", func(t *testing.T) {
+		g := philox.NewPhilox4x32(key)
+		g.Seek(42)
+		v1 := []uint32{g.Uint32(), g.Uint32(), g.Uint32()}
+		g.Seek(7)
+		g.Uint32()
+		g.Seek(42)
+		v2 := []uint32{g.Uint32(), g.Uint32(), g.Uint32()}
+		assert.Equal(t, v1, v2)
+	})
+
+	t.Run("counter carries over to the upper words", func(t *testing.T) {
+		g := philox.NewPhilox4x32(key)
+		g.Seek(0xffffffffffffffff)
+		for i := 0; i < 4; i++ {
+			g.Uint32()
+		}
+		block := philox.Block4x32([4]uint32{0, 0, 1, 0}, [2]uint32{0xa4093822, 0x299f31d0})
+		assert.Equal(t, block[0], g.Uint32())
+	})
+
+	t.Run("Uint64 combines two outputs", func(t *testing.T) {
+		g := philox.NewPhilox4x32(key)
+		block := philox.Block4x32([4]uint32{}, [2]uint32{0xa4093822, 0x299f31d0})
+		assert.Equal(t, uint64(block[1])<<32|uint64(block[0]), g.Uint64())
+		assert.Equal(t, uint64(block[3])<<32|uint64(block[2]), g.Uint64())
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		g := philox.NewPhilox4x32(0xc0ffee)
+		numSamples := 100
+		seq := make([]uint32, 0, numSamples)
+		for i := 0; i < numSamples; i++ {
+			seq = append(seq, g.Uint32())
+		}
+		snaps.MatchSnapshot(t, seq)
+	})
+}
@@ -0,0 +1,66 @@
+// Package splitmix provides the SplitMix64 generator, which is mainly used to initialize the states of other
+// generators from a single seed.
+// See https://prng.di.unimi.it/splitmix64.c for details of the algorithm.
+package splitmix
+
+import (
+	random32 "github.com/susisu/go-random/uint32"
+	random64 "github.com/susisu/go-random/uint64"
+)
+
+const gamma = 0x9e3779b97f4a7c15
+
+// SplitMix64 is the SplitMix64 generator, with 64-bit state and 64-bit output.
+type SplitMix64 struct {
+	state uint64
+}
+
+var _ random32.Generator = (*SplitMix64)(nil)
+var _ random64.Generator = (*SplitMix64)(nil)
+
+// NewSplitMix64 creates a new SplitMix64 generator initialized with the given seed.
+func NewSplitMix64(seed uint64) *SplitMix64 {
+	return &SplitMix64{seed}
+}
+
+// Seed initializes the generator with the given seed.
+func (s *SplitMix64) Seed(seed uint64) {
+	s.state = seed
+}
+
+// Uint64 returns a random uint64 value.
+func (s *SplitMix64) Uint64() uint64 {
+	s.state += gamma
+	z := s.state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// Uint32 returns a random uint32 value taken from the upper half of a 64-bit output.
+func (s *SplitMix64) Uint32() uint32 {
+	return uint32(s.Uint64() >> 32)
+}
+
+// Expand fills state with consecutive outputs of a SplitMix64 generator initialized with seed.
+// Since SplitMix64 never yields zero twice in a row, the filled state is never all zero if len(state) >= 2,
+// which makes it suitable for initializing generators such as xoshiro.
+func Expand(seed uint64, state []uint64) {
+	s := NewSplitMix64(seed)
+	for i := range state {
+		state[i] = s.Uint64()
+	}
+}
+
+// Expand32 is similar to Expand, but fills 32-bit words.
+// Each output of the SplitMix64 generator is split into two words, the lower half first.
+func Expand32(seed uint64, state []uint32) {
+	s := NewSplitMix64(seed)
+	for i := 0; i < len(state); i += 2 {
+		v := s.Uint64()
+		state[i] = uint32(v)
+		if i+1 < len(state) {
+			state[i+1] = uint32(v >> 32)
+		}
+	}
+}
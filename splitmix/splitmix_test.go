@@ -0,0 +1,79 @@
+package splitmix_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+	"github.com/susisu/go-random/splitmix"
+	"github.com/susisu/go-random/xoshiro"
+)
+
+func TestMain(t *testing.M) {
+	v := t.Run()
+	snaps.Clean(t)
+	os.Exit(v)
+}
+
+func TestSplitMix64(t *testing.T) {
+	t.Run("reference", func(t *testing.T) {
+		g := splitmix.NewSplitMix64(1234567)
+		expected := []uint64{
+			6457827717110365317, 3203168211198807973, 9817491932198370423, 4593380528125082431, 16408922859458223821,
+		}
+		for i, e := range expected {
+			assert.Equalf(t, e, g.Uint64(), "output %d", i)
+		}
+	})
+
+	t.Run("Uint32 takes the upper half", func(t *testing.T) {
+		g := splitmix.NewSplitMix64(1234567)
+		assert.Equal(t, uint32(6457827717110365317>>32), g.Uint32())
+	})
+
+	t.Run("Seed resets the state", func(t *testing.T) {
+		g := splitmix.NewSplitMix64(0)
+		g.Uint64()
+		g.Seed(1234567)
+		assert.Equal(t, uint64(6457827717110365317), g.Uint64())
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		g := splitmix.NewSplitMix64(0xc0ffee)
+		numSamples := 100
+		seq := make([]uint64, 0, numSamples)
+		for i := 0; i < numSamples; i++ {
+			seq = append(seq, g.Uint64())
+		}
+		snaps.MatchSnapshot(t, seq)
+	})
+}
+
+func TestExpand(t *testing.T) {
+	t.Run("fills state with outputs", func(t *testing.T) {
+		var state [4]uint64
+		splitmix.Expand(42, state[:])
+		assert.Equal(t, [4]uint64{0xbdd732262feb6e95, 0x28efe333b266f103, 0x47526757130f9f52, 0x581ce1ff0e4ae394}, state)
+	})
+
+	t.Run("can be used to seed xoshiro", func(t *testing.T) {
+		var state [4]uint64
+		splitmix.Expand(0, state[:])
+		assert.NotPanics(t, func() { xoshiro.NewXoshiro256StarStar(state) })
+	})
+}
+
+func TestExpand32(t *testing.T) {
+	t.Run("fills state with halves of outputs", func(t *testing.T) {
+		var state [4]uint32
+		splitmix.Expand32(42, state[:])
+		assert.Equal(t, [4]uint32{0x2feb6e95, 0xbdd73226, 0xb266f103, 0x28efe333}, state)
+	})
+
+	t.Run("fills odd length state", func(t *testing.T) {
+		var state [3]uint32
+		splitmix.Expand32(42, state[:])
+		assert.Equal(t, [3]uint32{0x2feb6e95, 0xbdd73226, 0xb266f103}, state)
+	})
+}
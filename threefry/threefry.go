@@ -0,0 +1,106 @@
+// Package threefry provides the Threefry4x64-20 counter-based generator.
+// See "Parallel Random Numbers: As Easy as 1, 2, 3" by Salmon et al. (2011) for details of the algorithm.
+package threefry
+
+import (
+	"math/bits"
+
+	random32 "github.com/susisu/go-random/uint32"
+	random64 "github.com/susisu/go-random/uint64"
+)
+
+const (
+	parity = 0x1bd11bdaa9fc1a22
+
+	numRounds = 20
+)
+
+var rotations = [8][2]int{
+	{14, 16}, {52, 57}, {23, 40}, {5, 37}, {25, 33}, {46, 12}, {58, 22}, {32, 32},
+}
+
+// Block4x64 computes the Threefry4x64-20 function, which maps a 256-bit counter to 256 random bits under a 256-bit
+// key.
+// It produces the same values as threefry4x64 of the reference Random123 implementation.
+func Block4x64(ctr [4]uint64, key [4]uint64) [4]uint64 {
+	ks := [5]uint64{key[0], key[1], key[2], key[3], parity ^ key[0] ^ key[1] ^ key[2] ^ key[3]}
+	x := ctr
+	for i := range x {
+		x[i] += ks[i]
+	}
+	for r := 0; r < numRounds; r++ {
+		rot := rotations[r%8]
+		if r%2 == 0 {
+			x[0] += x[1]
+			x[1] = bits.RotateLeft64(x[1], rot[0]) ^ x[0]
+			x[2] += x[3]
+			x[3] = bits.RotateLeft64(x[3], rot[1]) ^ x[2]
+		} else {
+			x[0] += x[3]
+			x[3] = bits.RotateLeft64(x[3], rot[0]) ^ x[0]
+			x[2] += x[1]
+			x[1] = bits.RotateLeft64(x[1], rot[1]) ^ x[2]
+		}
+		if r%4 == 3 {
+			s := r/4 + 1
+			for i := range x {
+				x[i] += ks[(s+i)%5]
+			}
+			x[3] += uint64(s)
+		}
+	}
+	return x
+}
+
+// Threefry4x64 is a generator that yields the outputs of Block4x64 for consecutive counters.
+// Each block yields four uint64 values, and any block can be reached in constant time with Seek.
+type Threefry4x64 struct {
+	key [4]uint64
+	ctr [4]uint64
+	buf [4]uint64
+	pos int
+}
+
+var _ random32.Generator = (*Threefry4x64)(nil)
+var _ random64.Generator = (*Threefry4x64)(nil)
+
+// NewThreefry4x64 creates a new Threefry4x64 generator with the given key, positioned at the block 0.
+func NewThreefry4x64(key [4]uint64) *Threefry4x64 {
+	return &Threefry4x64{
+		key: key,
+		pos: len(Threefry4x64{}.buf),
+	}
+}
+
+// Seek positions the generator at the start of the block specified by counter, discarding buffered values.
+// The next value is the first word of Block4x64 applied to the 256-bit counter whose lowest 64 bits are counter.
+func (t *Threefry4x64) Seek(counter uint64) {
+	t.ctr = [4]uint64{counter, 0, 0, 0}
+	t.pos = len(t.buf)
+}
+
+func (t *Threefry4x64) next() {
+	t.buf = Block4x64(t.ctr, t.key)
+	t.pos = 0
+	for i := range t.ctr {
+		t.ctr[i]++
+		if t.ctr[i] != 0 {
+			break
+		}
+	}
+}
+
+// Uint64 returns a random uint64 value.
+func (t *Threefry4x64) Uint64() uint64 {
+	if t.pos == len(t.buf) {
+		t.next()
+	}
+	v := t.buf[t.pos]
+	t.pos++
+	return v
+}
+
+// Uint32 returns a random uint32 value taken from the upper half of a 64-bit output.
+func (t *Threefry4x64) Uint32() uint32 {
+	return uint32(t.Uint64() >> 32)
+}
@@ -0,0 +1,91 @@
+package threefry_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+	"github.com/susisu/go-random/threefry"
+)
+
+func TestMain(t *testing.M) {
+	v := t.Run()
+	snaps.Clean(t)
+	os.Exit(v)
+}
+
+func TestBlock4x64(t *testing.T) {
+	// known-answer tests of the reference Random123 implementation
+	t.Run("zeros", func(t *testing.T) {
+		v := threefry.Block4x64([4]uint64{}, [4]uint64{})
+		assert.Equal(t, [4]uint64{0x09218ebde6c85537, 0x55941f5266d86105, 0x4bd25e16282434dc, 0xee29ec846bd2e40b}, v)
+	})
+
+	t.Run("ones", func(t *testing.T) {
+		m := ^uint64(0)
+		v := threefry.Block4x64([4]uint64{m, m, m, m}, [4]uint64{m, m, m, m})
+		assert.Equal(t, [4]uint64{0x29c24097942bba1b, 0x0371bbfb0f6f4e11, 0x3c231ffa33f83a1c, 0xcd29113fde32d168}, v)
+	})
+}
+
+func TestThreefry4x64(t *testing.T) {
+	key := [4]uint64{1, 2, 3, 4}
+
+	t.Run("yields blocks of consecutive counters", func(t *testing.T) {
+		g := threefry.NewThreefry4x64(key)
+		for c := uint64(0); c < 3; c++ {
+			block := threefry.Block4x64([4]uint64{c, 0, 0, 0}, key)
+			for i, e := range block {
+				assert.Equalf(t, e, g.Uint64(), "block %d, word %d", c, i)
+			}
+		}
+	})
+
+	t.Run("Seek positions at the start of the block", func(t *testing.T) {
+		g := threefry.NewThreefry4x64(key)
+		g.Uint64()
+		g.Seek(0x123456789)
+		block := threefry.Block4x64([4]uint64{0x123456789, 0, 0, 0}, key)
+		for i, e := range block {
+			assert.Equalf(t, e, g.Uint64(), "word %d", i)
+		}
+	})
+
+	t.Run("Seek gives the same values for the same counter", func(t *testing.T) {
+		g := threefry.NewThreefry4x64(key)
+		g.Seek(42)
+		v1 := []uint64{g.Uint64(), g.Uint64(), g.Uint64()}
+		g.Seek(7)
+		g.Uint64()
+		g.Seek(42)
+		v2 := []uint64{g.Uint64(), g.Uint64(), g.Uint64()}
+		assert.Equal(t, v1, v2)
+	})
+
+	t.Run("counter carries over to the upper words", func(t *testing.T) {
+		g := threefry.NewThreefry4x64(key)
+		g.Seek(0xffffffffffffffff)
+		for i := 0; i < 4; i++ {
+			g.Uint64()
+		}
+		block := threefry.Block4x64([4]uint64{0, 1, 0, 0}, key)
+		assert.Equal(t, block[0], g.Uint64())
+	})
+
+	t.Run("Uint32 takes the upper half", func(t *testing.T) {
+		g := threefry.NewThreefry4x64(key)
+		block := threefry.Block4x64([4]uint64{}, key)
+		assert.Equal(t, uint32(block[0]>>32), g.Uint32())
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		g := threefry.NewThreefry4x64([4]uint64{0xc0ffee, 0, 0, 0})
+		numSamples := 100
+		seq := make([]uint64, 0, numSamples)
+		for i := 0; i < numSamples; i++ {
+			seq = append(seq, g.Uint64())
+		}
+		snaps.MatchSnapshot(t, seq)
+	})
+}
@@ -0,0 +1,80 @@
+package random_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	random "github.com/susisu/go-random/uint32"
+)
+
+type countingGenerator struct {
+	g     random.Generator
+	draws int
+}
+
+func (g *countingGenerator) Uint32() uint32 {
+	g.draws++
+	return g.g.Uint32()
+}
+
+func benchmarkBounded(b *testing.B, generate func(g random.Generator)) {
+	b.Run("multiply-and-shift", func(b *testing.B) {
+		g := &countingGenerator{g: &testGenerator{rand.NewSource(42).(rand.Source64)}}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			generate(g)
+		}
+		b.ReportMetric(float64(g.draws)/float64(b.N), "draws/op")
+	})
+
+	b.Run("bitmask rejection", func(b *testing.B) {
+		cg := &countingGenerator{g: &testGenerator{rand.NewSource(42).(rand.Source64)}}
+		g := random.WithBitmaskRejection(cg)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			generate(g)
+		}
+		b.ReportMetric(float64(cg.draws)/float64(b.N), "draws/op")
+	})
+}
+
+func BenchmarkInt64Between(b *testing.B) {
+	b.Run("small range", func(b *testing.B) {
+		benchmarkBounded(b, func(g random.Generator) {
+			random.Int64Between(g, 0, 5)
+		})
+	})
+
+	b.Run("just above a power of two", func(b *testing.B) {
+		benchmarkBounded(b, func(g random.Generator) {
+			random.Int64Between(g, 0, 1<<32)
+		})
+	})
+
+	b.Run("large range", func(b *testing.B) {
+		benchmarkBounded(b, func(g random.Generator) {
+			random.Int64Between(g, 0, math.MaxInt64/3*2)
+		})
+	})
+}
+
+func BenchmarkUint32Between(b *testing.B) {
+	b.Run("small range", func(b *testing.B) {
+		benchmarkBounded(b, func(g random.Generator) {
+			random.Uint32Between(g, 0, 5)
+		})
+	})
+
+	b.Run("just above a power of two", func(b *testing.B) {
+		benchmarkBounded(b, func(g random.Generator) {
+			random.Uint32Between(g, 0, 1<<31)
+		})
+	})
+}
+
+func BenchmarkFloat64(b *testing.B) {
+	benchmarkBounded(b, func(g random.Generator) {
+		random.Float64(g)
+	})
+}
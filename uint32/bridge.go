@@ -0,0 +1,31 @@
+package random
+
+// Uint64Generator is an abstract random number generator that yields uint64 values, i.e. the uint64 version of
+// Generator.
+type Uint64Generator interface {
+	Uint64() uint64
+}
+
+type uint64Bridge struct {
+	g     Uint64Generator
+	hi    uint32
+	hasHi bool
+}
+
+// FromUint64Generator wraps a generator that yields uint64 values as a Generator.
+// Each 64-bit value is split into two values, which are yielded in order of the lower half and the upper half, so no
+// bits are discarded.
+func FromUint64Generator(g Uint64Generator) Generator {
+	return &uint64Bridge{g: g}
+}
+
+func (b *uint64Bridge) Uint32() uint32 {
+	if b.hasHi {
+		b.hasHi = false
+		return b.hi
+	}
+	v := b.g.Uint64()
+	b.hi = uint32(v >> 32)
+	b.hasHi = true
+	return uint32(v)
+}
@@ -0,0 +1,53 @@
+package random_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint32"
+	random64 "github.com/susisu/go-random/uint64"
+)
+
+type sequenceGenerator64 struct {
+	seq []uint64
+	pos int
+}
+
+func (g *sequenceGenerator64) Uint64() uint64 {
+	v := g.seq[g.pos]
+	g.pos++
+	return v
+}
+
+func TestFromUint64Generator(t *testing.T) {
+	t.Run("does not keep the bitmask rejection mode of the uint64 version", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		a := random.FromUint64Generator(random64.WithBitmaskRejection(g1.src))
+		b := random.FromUint64Generator(g2.src)
+		for i := 0; i < 100; i++ {
+			assert.Equal(t, random.IntBetween(b, 0, 100), random.IntBetween(a, 0, 100))
+		}
+	})
+
+	t.Run("splits each value, the lower half first", func(t *testing.T) {
+		g := random.FromUint64Generator(&sequenceGenerator64{seq: []uint64{0x89abcdef01234567, 0x00c0ffeedeadbeef}})
+		assert.Equal(t, uint32(0x01234567), g.Uint32())
+		assert.Equal(t, uint32(0x89abcdef), g.Uint32())
+		assert.Equal(t, uint32(0xdeadbeef), g.Uint32())
+		assert.Equal(t, uint32(0x00c0ffee), g.Uint32())
+	})
+
+	t.Run("Uint64 reproduces the original values", func(t *testing.T) {
+		g := random.FromUint64Generator(&sequenceGenerator64{seq: []uint64{0x89abcdef01234567, 0x00c0ffeedeadbeef}})
+		assert.Equal(t, uint64(0x89abcdef01234567), random.Uint64(g))
+		assert.Equal(t, uint64(0x00c0ffeedeadbeef), random.Uint64(g))
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		g := random.FromUint64Generator(initTestGenerator().src)
+		testLargeIntegerUniformDistribution(t, 0, math.MaxUint32, func(_ random.Generator) uint32 {
+			return g.Uint32()
+		})
+	})
+}
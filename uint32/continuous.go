@@ -0,0 +1,127 @@
+package random
+
+import "math"
+
+// Exponential returns a random float64 value that follows the exponential distribution with the given rate.
+// It panics if rate <= 0 is given.
+func Exponential(g Generator, rate float64) float64 {
+	if rate > 0 {
+		return -math.Log(Float64Open(g)) / rate
+	} else {
+		panic("invalid argument to Exponential: rate must be greater than 0")
+	}
+}
+
+// Gamma returns a random float64 value that follows the gamma distribution with the given shape and scale.
+// It uses the method of Marsaglia and Tsang, "A Simple Method for Generating Gamma Variables" (2000).
+// It panics if shape <= 0 or scale <= 0 is given.
+func Gamma(g Generator, shape, scale float64) float64 {
+	if !(shape > 0) {
+		panic("invalid argument to Gamma: shape must be greater than 0")
+	}
+	if !(scale > 0) {
+		panic("invalid argument to Gamma: scale must be greater than 0")
+	}
+	return stdGamma(g, shape) * scale
+}
+
+// stdGamma returns a random value that follows the gamma distribution with the given shape and scale 1.
+func stdGamma(g Generator, shape float64) float64 {
+	if shape < 1 {
+		// Gamma(shape) = Gamma(shape + 1) * U^(1/shape), computed in log space to avoid underflow
+		x := stdGamma(g, shape+1)
+		u := Float64Open(g)
+		return math.Exp(math.Log(x) + math.Log(u)/shape)
+	}
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		var x, v float64
+		for {
+			x = StdNormal(g)
+			v = 1 + c*x
+			if v > 0 {
+				break
+			}
+		}
+		v = v * v * v
+		u := Float64Open(g)
+		if u < 1-0.0331*(x*x)*(x*x) {
+			return d * v
+		}
+		if math.Log(u) < 0.5*x*x+d*(1-v+math.Log(v)) {
+			return d * v
+		}
+	}
+}
+
+// Beta returns a random float64 value that follows the beta distribution with the given shape parameters a and b.
+// It panics if a <= 0 or b <= 0 is given.
+func Beta(g Generator, a, b float64) float64 {
+	if !(a > 0) {
+		panic("invalid argument to Beta: a must be greater than 0")
+	}
+	if !(b > 0) {
+		panic("invalid argument to Beta: b must be greater than 0")
+	}
+	if a <= 1 && b <= 1 {
+		// Jöhnk's algorithm, which avoids the underflow of gamma variables with small shapes
+		for {
+			u := Float64Open(g)
+			v := Float64Open(g)
+			x := math.Pow(u, 1/a)
+			y := math.Pow(v, 1/b)
+			if x+y <= 1 {
+				if x+y > 0 {
+					return x / (x + y)
+				} else {
+					logX := math.Log(u) / a
+					logY := math.Log(v) / b
+					logM := math.Max(logX, logY)
+					logX -= logM
+					logY -= logM
+					return math.Exp(logX - math.Log(math.Exp(logX)+math.Exp(logY)))
+				}
+			}
+		}
+	} else {
+		x := stdGamma(g, a)
+		y := stdGamma(g, b)
+		return x / (x + y)
+	}
+}
+
+// ChiSquared returns a random float64 value that follows the chi-squared distribution with k degrees of freedom.
+// It panics if k <= 0 is given.
+func ChiSquared(g Generator, k float64) float64 {
+	if k > 0 {
+		return 2 * stdGamma(g, k/2)
+	} else {
+		panic("invalid argument to ChiSquared: k must be greater than 0")
+	}
+}
+
+// StudentT returns a random float64 value that follows Student's t-distribution with nu degrees of freedom.
+// It panics if nu <= 0 is given.
+func StudentT(g Generator, nu float64) float64 {
+	if nu > 0 {
+		z := StdNormal(g)
+		return z / math.Sqrt(2*stdGamma(g, nu/2)/nu)
+	} else {
+		panic("invalid argument to StudentT: nu must be greater than 0")
+	}
+}
+
+// FisherF returns a random float64 value that follows the F-distribution with d1 and d2 degrees of freedom.
+// It panics if d1 <= 0 or d2 <= 0 is given.
+func FisherF(g Generator, d1, d2 float64) float64 {
+	if !(d1 > 0) {
+		panic("invalid argument to FisherF: d1 must be greater than 0")
+	}
+	if !(d2 > 0) {
+		panic("invalid argument to FisherF: d2 must be greater than 0")
+	}
+	x := 2 * stdGamma(g, d1/2) / d1
+	y := 2 * stdGamma(g, d2/2) / d2
+	return x / y
+}
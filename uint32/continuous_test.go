@@ -0,0 +1,339 @@
+package random_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint32"
+)
+
+// regularizedGammaP computes the regularized lower incomplete gamma function P(a, x).
+func regularizedGammaP(a, x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	lg, _ := math.Lgamma(a)
+	if x < a+1 {
+		// series expansion
+		sum := 1 / a
+		term := sum
+		for n := 1; n < 1000; n++ {
+			term *= x / (a + float64(n))
+			sum += term
+			if math.Abs(term) < math.Abs(sum)*1e-15 {
+				break
+			}
+		}
+		return sum * math.Exp(-x+a*math.Log(x)-lg)
+	}
+	// continued fraction (modified Lentz's method)
+	const tiny = 1e-300
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for n := 1; n < 1000; n++ {
+		an := -float64(n) * (float64(n) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < 1e-15 {
+			break
+		}
+	}
+	return 1 - math.Exp(-x+a*math.Log(x)-lg)*h
+}
+
+// regularizedBetaI computes the regularized incomplete beta function I_x(a, b).
+func regularizedBetaI(x, a, b float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+	if x > (a+1)/(a+b+2) {
+		return 1 - regularizedBetaI(1-x, b, a)
+	}
+	lga, _ := math.Lgamma(a)
+	lgb, _ := math.Lgamma(b)
+	lgab, _ := math.Lgamma(a + b)
+	front := math.Exp(lgab - lga - lgb + a*math.Log(x) + b*math.Log(1-x))
+	// continued fraction (modified Lentz's method)
+	const tiny = 1e-300
+	c := 1.0
+	d := 1 - (a+b)*x/(a+1)
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+	for m := 1; m < 1000; m++ {
+		fm := float64(m)
+		for _, an := range []float64{
+			fm * (b - fm) * x / ((a + 2*fm - 1) * (a + 2*fm)),
+			-(a + fm) * (a + b + fm) * x / ((a + 2*fm) * (a + 2*fm + 1)),
+		} {
+			d = 1 + an*d
+			if math.Abs(d) < tiny {
+				d = tiny
+			}
+			c = 1 + an/c
+			if math.Abs(c) < tiny {
+				c = tiny
+			}
+			d = 1 / d
+			h *= d * c
+		}
+		if math.Abs(d*c-1) < 1e-15 {
+			break
+		}
+	}
+	return front * h / a
+}
+
+func gammaCDF(shape, scale float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		return regularizedGammaP(shape, x/scale)
+	}
+}
+
+func betaCDF(a, b float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		return regularizedBetaI(x, a, b)
+	}
+}
+
+func studentTCDF(nu float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		p := 0.5 * regularizedBetaI(nu/(nu+x*x), nu/2, 0.5)
+		if x > 0 {
+			return 1 - p
+		} else {
+			return p
+		}
+	}
+}
+
+func fisherFCDF(d1, d2 float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if x <= 0 {
+			return 0
+		}
+		return regularizedBetaI(d1*x/(d1*x+d2), d1/2, d2/2)
+	}
+}
+
+func TestExponential(t *testing.T) {
+	t.Run("panics if rate <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Exponential(g, 0) })
+		assert.Panics(t, func() { random.Exponential(g, -1) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.Exponential(g, 2)
+		})
+	})
+
+	t.Run("moments", func(t *testing.T) {
+		testMoments(t, 0.5, 0.25, func(g random.Generator) float64 {
+			return random.Exponential(g, 2)
+		})
+	})
+
+	t.Run("goodness of fit", func(t *testing.T) {
+		testKolmogorovSmirnov(
+			t,
+			func(x float64) float64 {
+				return 1 - math.Exp(-2*x)
+			},
+			func(g random.Generator) float64 {
+				return random.Exponential(g, 2)
+			},
+		)
+	})
+}
+
+func TestGamma(t *testing.T) {
+	t.Run("panics if shape <= 0 or scale <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Gamma(g, 0, 1) })
+		assert.Panics(t, func() { random.Gamma(g, 1, 0) })
+		assert.Panics(t, func() { random.Gamma(g, math.NaN(), 1) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		skipArchDependentSnapshot(t)
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.Gamma(g, 2.5, 2)
+		})
+	})
+
+	for _, shape := range []float64{0.1, 0.5, 1, 2.5, 30} {
+		shape := shape
+		scale := 2.0
+
+		t.Run(fmt.Sprintf("moments (shape = %g)", shape), func(t *testing.T) {
+			testMoments(t, shape*scale, shape*scale*scale, func(g random.Generator) float64 {
+				return random.Gamma(g, shape, scale)
+			})
+		})
+
+		t.Run(fmt.Sprintf("goodness of fit (shape = %g)", shape), func(t *testing.T) {
+			testKolmogorovSmirnov(t, gammaCDF(shape, scale), func(g random.Generator) float64 {
+				return random.Gamma(g, shape, scale)
+			})
+		})
+	}
+
+	t.Run("tiny shape", func(t *testing.T) {
+		g := initTestGenerator()
+		for i := 0; i < 1000; i++ {
+			v := random.Gamma(g, 1e-3, 1)
+			assert.False(t, math.IsNaN(v))
+			assert.GreaterOrEqual(t, v, 0.0)
+		}
+	})
+}
+
+func TestBeta(t *testing.T) {
+	t.Run("panics if a <= 0 or b <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Beta(g, 0, 1) })
+		assert.Panics(t, func() { random.Beta(g, 1, 0) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		skipArchDependentSnapshot(t)
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.Beta(g, 2, 5)
+		})
+	})
+
+	for _, params := range [][2]float64{{0.5, 0.5}, {0.3, 0.8}, {2, 5}, {1, 3}, {40, 60}} {
+		a, b := params[0], params[1]
+
+		t.Run(fmt.Sprintf("moments (a = %g, b = %g)", a, b), func(t *testing.T) {
+			mean := a / (a + b)
+			variance := a * b / ((a + b) * (a + b) * (a + b + 1))
+			testMoments(t, mean, variance, func(g random.Generator) float64 {
+				return random.Beta(g, a, b)
+			})
+		})
+
+		t.Run(fmt.Sprintf("goodness of fit (a = %g, b = %g)", a, b), func(t *testing.T) {
+			testKolmogorovSmirnov(t, betaCDF(a, b), func(g random.Generator) float64 {
+				return random.Beta(g, a, b)
+			})
+		})
+	}
+
+	t.Run("tiny shapes", func(t *testing.T) {
+		g := initTestGenerator()
+		for i := 0; i < 1000; i++ {
+			v := random.Beta(g, 1e-3, 1e-3)
+			assert.False(t, math.IsNaN(v))
+			assert.GreaterOrEqual(t, v, 0.0)
+			assert.LessOrEqual(t, v, 1.0)
+		}
+	})
+}
+
+func TestChiSquared(t *testing.T) {
+	t.Run("panics if k <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.ChiSquared(g, 0) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		skipArchDependentSnapshot(t)
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.ChiSquared(g, 3)
+		})
+	})
+
+	t.Run("moments", func(t *testing.T) {
+		testMoments(t, 3, 6, func(g random.Generator) float64 {
+			return random.ChiSquared(g, 3)
+		})
+	})
+
+	t.Run("goodness of fit", func(t *testing.T) {
+		testKolmogorovSmirnov(t, gammaCDF(1.5, 2), func(g random.Generator) float64 {
+			return random.ChiSquared(g, 3)
+		})
+	})
+}
+
+func TestStudentT(t *testing.T) {
+	t.Run("panics if nu <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.StudentT(g, 0) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		skipArchDependentSnapshot(t)
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.StudentT(g, 10)
+		})
+	})
+
+	t.Run("moments", func(t *testing.T) {
+		testMoments(t, 0, 10.0/8.0, func(g random.Generator) float64 {
+			return random.StudentT(g, 10)
+		})
+	})
+
+	for _, nu := range []float64{1, 3, 10} {
+		nu := nu
+
+		t.Run(fmt.Sprintf("goodness of fit (nu = %g)", nu), func(t *testing.T) {
+			testKolmogorovSmirnov(t, studentTCDF(nu), func(g random.Generator) float64 {
+				return random.StudentT(g, nu)
+			})
+		})
+	}
+}
+
+func TestFisherF(t *testing.T) {
+	t.Run("panics if d1 <= 0 or d2 <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.FisherF(g, 0, 1) })
+		assert.Panics(t, func() { random.FisherF(g, 1, 0) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		skipArchDependentSnapshot(t)
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.FisherF(g, 5, 20)
+		})
+	})
+
+	t.Run("moments", func(t *testing.T) {
+		d1, d2 := 5.0, 20.0
+		mean := d2 / (d2 - 2)
+		variance := 2 * d2 * d2 * (d1 + d2 - 2) / (d1 * (d2 - 2) * (d2 - 2) * (d2 - 4))
+		testMoments(t, mean, variance, func(g random.Generator) float64 {
+			return random.FisherF(g, d1, d2)
+		})
+	})
+
+	t.Run("goodness of fit", func(t *testing.T) {
+		testKolmogorovSmirnov(t, fisherFCDF(5, 20), func(g random.Generator) float64 {
+			return random.FisherF(g, 5, 20)
+		})
+	})
+}
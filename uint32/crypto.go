@@ -0,0 +1,90 @@
+package random
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// ErrorPolicy specifies how CryptoGenerator handles errors from the underlying reader.
+type ErrorPolicy int
+
+const (
+	// PanicOnError makes the generator panic when reading from the underlying reader fails.
+	PanicOnError ErrorPolicy = iota
+	// StickyError makes the generator record the first error, which can be retrieved by Err, and yield 0 after that.
+	//
+	// WARNING: the output is unusable after an error, and any value generated from it (e.g. by Float64 or Shuffle)
+	// must be discarded.
+	// Check Err after generating values, before using them.
+	// Functions using rejection sampling (e.g. IntBetween and StdNormal) may never return after an error, so use
+	// PanicOnError with them unless the reader is known not to fail.
+	StickyError
+)
+
+// DefaultCryptoBufferSize is the buffer size used by CryptoGenerator if no valid size is given.
+const DefaultCryptoBufferSize = 512
+
+// CryptoGenerator is a generator that reads random bytes from an io.Reader, crypto/rand.Reader by default, through
+// an internal buffer.
+// Each value is read in little-endian order.
+type CryptoGenerator struct {
+	r      io.Reader
+	buf    []byte
+	pos    int
+	policy ErrorPolicy
+	err    error
+}
+
+var _ Generator = (*CryptoGenerator)(nil)
+
+// NewCryptoGenerator creates a new CryptoGenerator that reads from r.
+// If r is nil, crypto/rand.Reader is used.
+// bufSize is rounded up to a multiple of 4, and DefaultCryptoBufferSize is used if bufSize <= 0.
+func NewCryptoGenerator(r io.Reader, bufSize int, policy ErrorPolicy) *CryptoGenerator {
+	if r == nil {
+		r = rand.Reader
+	}
+	if bufSize <= 0 {
+		bufSize = DefaultCryptoBufferSize
+	}
+	bufSize = (bufSize + 3) &^ 3
+	return &CryptoGenerator{
+		r:      r,
+		buf:    make([]byte, bufSize),
+		pos:    bufSize,
+		policy: policy,
+	}
+}
+
+// Err returns the first error occurred while reading, if the policy is StickyError.
+func (g *CryptoGenerator) Err() error {
+	return g.err
+}
+
+func (g *CryptoGenerator) fill() bool {
+	if g.err != nil {
+		return false
+	}
+	if _, err := io.ReadFull(g.r, g.buf); err != nil {
+		if g.policy == PanicOnError {
+			panic(fmt.Errorf("CryptoGenerator: failed to read random bytes: %w", err))
+		}
+		g.err = err
+		return false
+	}
+	g.pos = 0
+	return true
+}
+
+// Uint32 returns a random uint32 value.
+// If reading has failed and the policy is StickyError, it returns 0.
+func (g *CryptoGenerator) Uint32() uint32 {
+	if g.pos == len(g.buf) && !g.fill() {
+		return 0
+	}
+	v := binary.LittleEndian.Uint32(g.buf[g.pos:])
+	g.pos += 4
+	return v
+}
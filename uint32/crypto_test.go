@@ -0,0 +1,81 @@
+package random_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint32"
+)
+
+type countingReader struct {
+	r     io.Reader
+	reads []int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.reads = append(r.reads, n)
+	return n, err
+}
+
+func sequentialBytes(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i)
+	}
+	return b
+}
+
+func TestCryptoGenerator(t *testing.T) {
+	t.Run("reads values in little-endian order", func(t *testing.T) {
+		g := random.NewCryptoGenerator(bytes.NewReader(sequentialBytes(16)), 8, random.PanicOnError)
+		assert.Equal(t, uint32(0x03020100), g.Uint32())
+		assert.Equal(t, uint32(0x07060504), g.Uint32())
+		assert.Equal(t, uint32(0x0b0a0908), g.Uint32())
+		assert.Equal(t, uint32(0x0f0e0d0c), g.Uint32())
+	})
+
+	t.Run("reads through the buffer", func(t *testing.T) {
+		r := &countingReader{r: bytes.NewReader(sequentialBytes(32))}
+		g := random.NewCryptoGenerator(r, 6, random.PanicOnError)
+		for i := 0; i < 4; i++ {
+			g.Uint32()
+		}
+		assert.Equal(t, []int{8, 8}, r.reads)
+	})
+
+	t.Run("panics on error if the policy is PanicOnError", func(t *testing.T) {
+		g := random.NewCryptoGenerator(iotest.ErrReader(errors.New("fail")), 0, random.PanicOnError)
+		assert.Panics(t, func() { g.Uint32() })
+	})
+
+	t.Run("records the first error if the policy is StickyError", func(t *testing.T) {
+		g := random.NewCryptoGenerator(bytes.NewReader(sequentialBytes(6)), 4, random.StickyError)
+		assert.Equal(t, uint32(0x03020100), g.Uint32())
+		assert.NoError(t, g.Err())
+		assert.Equal(t, uint32(0), g.Uint32())
+		assert.ErrorIs(t, g.Err(), io.ErrUnexpectedEOF)
+		assert.Equal(t, uint32(0), g.Uint32())
+		assert.ErrorIs(t, g.Err(), io.ErrUnexpectedEOF)
+	})
+
+	t.Run("yields only 0 after an error if the policy is StickyError", func(t *testing.T) {
+		g := random.NewCryptoGenerator(iotest.ErrReader(errors.New("fail")), 0, random.StickyError)
+		for i := 0; i < 1000; i++ {
+			assert.Equal(t, uint32(0), g.Uint32())
+		}
+		assert.Error(t, g.Err())
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		g := random.NewCryptoGenerator(nil, 0, random.PanicOnError)
+		testLargeIntegerUniformDistribution(t, 0, math.MaxUint32, func(_ random.Generator) uint32 {
+			return random.Uint32(g)
+		})
+	})
+}
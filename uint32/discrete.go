@@ -0,0 +1,361 @@
+package random
+
+import "math"
+
+func logGamma(x float64) float64 {
+	v, _ := math.Lgamma(x)
+	return v
+}
+
+// Binomial returns a random int64 value that follows the binomial distribution, i.e. the number of successes in n
+// independent trials each of which succeeds with probability p.
+// It uses the inversion method if n * min(p, 1 - p) is small, and the BTPE algorithm of Kachitvichyanukul and
+// Schmeiser, "Binomial Random Variate Generation" (1988) otherwise.
+// It panics if n < 0 is given or p is not within the range [0, 1].
+func Binomial(g Generator, n int64, p float64) int64 {
+	if n < 0 {
+		panic("invalid argument to Binomial: n must be greater than or equal to 0")
+	}
+	if !(p >= 0 && p <= 1) {
+		panic("invalid argument to Binomial: p must be within the range [0, 1]")
+	}
+	if n == 0 || p == 0 {
+		return 0
+	} else if p == 1 {
+		return n
+	} else if p <= 0.5 {
+		return binomialSmallP(g, n, p)
+	} else {
+		return n - binomialSmallP(g, n, 1-p)
+	}
+}
+
+// binomialSmallP samples from the binomial distribution with 0 < p <= 0.5.
+func binomialSmallP(g Generator, n int64, p float64) int64 {
+	if float64(n)*p <= 30 {
+		return binomialInversion(g, n, p)
+	} else {
+		return binomialBTPE(g, n, p)
+	}
+}
+
+func binomialInversion(g Generator, n int64, p float64) int64 {
+	q := 1 - p
+	qn := math.Exp(float64(n) * math.Log(q))
+	np := float64(n) * p
+	bound := math.Min(float64(n), np+10*math.Sqrt(np*q+1))
+
+	var x int64
+	px := qn
+	u := Float64(g)
+	for u > px {
+		x++
+		if float64(x) > bound {
+			x = 0
+			px = qn
+			u = Float64(g)
+		} else {
+			u -= px
+			px = (float64(n-x+1) * p * px) / (float64(x) * q)
+		}
+	}
+	return x
+}
+
+func binomialBTPE(g Generator, n int64, p float64) int64 {
+	fn := float64(n)
+	q := 1 - p
+	nrq := fn * p * q
+	fm := fn*p + p
+	m := math.Floor(fm)
+	p1 := math.Floor(2.195*math.Sqrt(nrq)-4.6*q) + 0.5
+	xm := m + 0.5
+	xl := xm - p1
+	xr := xm + p1
+	c := 0.134 + 20.5/(15.3+m)
+	a := (fm - xl) / (fm - xl*p)
+	laml := a * (1 + a/2)
+	a = (xr - fm) / (xr * q)
+	lamr := a * (1 + a/2)
+	p2 := p1 * (1 + 2*c)
+	p3 := p2 + c/laml
+	p4 := p3 + c/lamr
+
+	for {
+		u := Float64(g) * p4
+		v := Float64(g)
+		var y float64
+		if u <= p1 {
+			// the triangular region
+			return int64(math.Floor(xm - p1*v + u))
+		} else if u <= p2 {
+			// the parallelograms
+			x := xl + (u-p1)/c
+			v = v*c + 1 - math.Abs(m-x+0.5)/p1
+			if v > 1 {
+				continue
+			}
+			y = math.Floor(x)
+		} else if u <= p3 {
+			// the left exponential tail
+			y = math.Floor(xl + math.Log(v)/laml)
+			if y < 0 || v == 0 {
+				continue
+			}
+			v = v * (u - p2) * laml
+		} else {
+			// the right exponential tail
+			y = math.Floor(xr - math.Log(v)/lamr)
+			if y > fn || v == 0 {
+				continue
+			}
+			v = v * (u - p3) * lamr
+		}
+
+		k := math.Abs(y - m)
+		if k <= 20 || k >= nrq/2-1 {
+			// explicit evaluation
+			s := p / q
+			a := s * (fn + 1)
+			f := 1.0
+			if m < y {
+				for i := m + 1; i <= y; i++ {
+					f *= a/i - s
+				}
+			} else if m > y {
+				for i := y + 1; i <= m; i++ {
+					f /= a/i - s
+				}
+			}
+			if v <= f {
+				return int64(y)
+			}
+			continue
+		}
+
+		// squeezing using upper and lower bounds on log(f(y))
+		rho := (k / nrq) * ((k*(k/3+0.625)+0.1666666666666)/nrq + 0.5)
+		t := -k * k / (2 * nrq)
+		logV := math.Log(v)
+		if logV < t-rho {
+			return int64(y)
+		}
+		if logV > t+rho {
+			continue
+		}
+
+		// the final acceptance/rejection test, using Stirling's formula
+		x1 := y + 1
+		f1 := m + 1
+		z := fn + 1 - m
+		w := fn - y + 1
+		bound := xm*math.Log(f1/x1) + (fn-m+0.5)*math.Log(z/w) + (y-m)*math.Log(w*p/(x1*q)) +
+			stirlingCorrection(f1) + stirlingCorrection(z) + stirlingCorrection(x1) + stirlingCorrection(w)
+		if logV <= bound {
+			return int64(y)
+		}
+	}
+}
+
+func stirlingCorrection(x float64) float64 {
+	x2 := x * x
+	return (13680 - (462-(132-(99-140/x2)/x2)/x2)/x2) / x / 166320
+}
+
+// poissonLambdaMax is the maximum lambda for which the values of the Poisson distribution fit in int64 in practice.
+const poissonLambdaMax = math.MaxInt64 - 10*3037000499.97605 // math.MaxInt64 - 10 * sqrt(math.MaxInt64)
+
+// Poisson returns a random int64 value that follows the Poisson distribution with mean lambda.
+// It uses the multiplication method if lambda < 10, and the PTRS algorithm of Hörmann, "The Transformed Rejection
+// Method for Generating Poisson Random Variables" (1993) otherwise.
+// It panics if lambda is not within the range [0, 9.2e18].
+func Poisson(g Generator, lambda float64) int64 {
+	if !(lambda >= 0 && lambda <= poissonLambdaMax) {
+		panic("invalid argument to Poisson: lambda must be within the range [0, 9.2e18]")
+	}
+	if lambda == 0 {
+		return 0
+	} else if lambda < 10 {
+		return poissonMultiplication(g, lambda)
+	} else {
+		return poissonPTRS(g, lambda)
+	}
+}
+
+func poissonMultiplication(g Generator, lambda float64) int64 {
+	enlam := math.Exp(-lambda)
+	var x int64
+	prod := 1.0
+	for {
+		prod *= Float64(g)
+		if prod > enlam {
+			x++
+		} else {
+			return x
+		}
+	}
+}
+
+func poissonPTRS(g Generator, lambda float64) int64 {
+	slam := math.Sqrt(lambda)
+	logLam := math.Log(lambda)
+	b := 0.931 + 2.53*slam
+	a := -0.059 + 0.02483*b
+	invAlpha := 1.1239 + 1.1328/(b-3.4)
+	vr := 0.9277 - 3.6224/(b-2)
+
+	for {
+		u := Float64(g) - 0.5
+		v := Float64(g)
+		us := 0.5 - math.Abs(u)
+		k := math.Floor((2*a/us+b)*u + lambda + 0.43)
+		if us >= 0.07 && v <= vr {
+			return int64(k)
+		}
+		if k < 0 || (us < 0.013 && v > us) {
+			continue
+		}
+		if math.Log(v)+math.Log(invAlpha)-math.Log(a/(us*us)+b) <= -lambda+k*logLam-logGamma(k+1) {
+			return int64(k)
+		}
+	}
+}
+
+// Geometric returns a random int64 value that follows the geometric distribution, i.e. the number of failures before
+// the first success in independent trials each of which succeeds with probability p.
+// It uses the inversion method, and returns math.MaxInt64 if the value does not fit in int64.
+// It panics if p is not within the range (0, 1].
+func Geometric(g Generator, p float64) int64 {
+	if !(p > 0 && p <= 1) {
+		panic("invalid argument to Geometric: p must be within the range (0, 1]")
+	}
+	if p == 1 {
+		return 0
+	}
+	v := math.Floor(math.Log(Float64Open(g)) / math.Log1p(-p))
+	if v >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(v)
+}
+
+// NegativeBinomial returns a random int64 value that follows the negative binomial distribution, i.e. the number of
+// failures before r successes in independent trials each of which succeeds with probability p.
+// r can be any positive real number.
+// It samples from the Poisson distribution whose mean follows the gamma distribution.
+// It panics if r <= 0 is given or p is not within the range (0, 1].
+func NegativeBinomial(g Generator, r, p float64) int64 {
+	if !(r > 0) {
+		panic("invalid argument to NegativeBinomial: r must be greater than 0")
+	}
+	if !(p > 0 && p <= 1) {
+		panic("invalid argument to NegativeBinomial: p must be within the range (0, 1]")
+	}
+	if p == 1 {
+		return 0
+	}
+	lambda := stdGamma(g, r) * (1 - p) / p
+	return Poisson(g, math.Min(lambda, poissonLambdaMax))
+}
+
+// Hypergeometric returns a random int64 value that follows the hypergeometric distribution, i.e. the number of good
+// items in a sample of the given size drawn without replacement from good good items and bad bad items.
+// It uses the urn method if sample < 10, and the HRUA algorithm of Stadlober, "Sampling from Poisson, binomial and
+// hypergeometric distributions: ratio of uniforms as a simple and fast alternative" (1989) otherwise.
+// It panics if good < 0, bad < 0, sample < 0, or sample > good + bad is given.
+func Hypergeometric(g Generator, good, bad, sample int64) int64 {
+	if good < 0 || bad < 0 || sample < 0 {
+		panic("invalid argument to Hypergeometric: good, bad and sample must be greater than or equal to 0")
+	}
+	if good > math.MaxInt64-bad || sample > good+bad {
+		panic("invalid argument to Hypergeometric: sample must be less than or equal to good + bad")
+	}
+	if sample < 10 {
+		return hypergeometricUrn(g, good, bad, sample)
+	} else {
+		return hypergeometricHRUA(g, good, bad, sample)
+	}
+}
+
+func hypergeometricUrn(g Generator, good, bad, sample int64) int64 {
+	d1 := float64(bad + good - sample)
+	d2 := float64(good)
+	if bad < good {
+		d2 = float64(bad)
+	}
+	y := d2
+	k := float64(sample)
+	for y > 0 && k > 0 {
+		u := Float64(g)
+		y -= math.Floor(u + y/(d1+k))
+		k--
+	}
+	z := int64(d2 - y)
+	if good > bad {
+		z = sample - z
+	}
+	return z
+}
+
+func hypergeometricHRUA(g Generator, good, bad, sample int64) int64 {
+	const (
+		d1 = 1.7155277699214135 // 2 * sqrt(2 / e)
+		d2 = 0.8989161620588988 // 3 - 2 * sqrt(3 / e)
+	)
+	minGoodBad := good
+	maxGoodBad := bad
+	if bad < good {
+		minGoodBad, maxGoodBad = bad, good
+	}
+	popSize := good + bad
+	m := sample
+	if popSize-sample < m {
+		m = popSize - sample
+	}
+
+	fMin := float64(minGoodBad)
+	fMax := float64(maxGoodBad)
+	fPop := float64(popSize)
+	fm := float64(m)
+	d4 := fMin / fPop
+	d5 := 1 - d4
+	d6 := fm*d4 + 0.5
+	d7 := math.Sqrt((fPop-fm)*float64(sample)*d4*d5/(fPop-1) + 0.5)
+	d8 := d1*d7 + d2
+	d9 := math.Floor((fm + 1) * (fMin + 1) / (fPop + 2))
+	d10 := logGamma(d9+1) + logGamma(fMin-d9+1) + logGamma(fm-d9+1) + logGamma(fMax-fm+d9+1)
+	d11 := math.Min(math.Min(fm, fMin)+1, math.Floor(d6+16*d7))
+
+	var z float64
+	for {
+		x := Float64(g)
+		y := Float64(g)
+		w := d6 + d8*(y-0.5)/x
+		if w < 0 || w >= d11 {
+			continue
+		}
+		z = math.Floor(w)
+		t := d10 - (logGamma(z+1) + logGamma(fMin-z+1) + logGamma(fm-z+1) + logGamma(fMax-fm+z+1))
+		if x*(4-x)-3 <= t {
+			break
+		}
+		if x*(x-t) >= 1 {
+			continue
+		}
+		if x == 0 {
+			continue
+		}
+		if 2*math.Log(x) <= t {
+			break
+		}
+	}
+
+	v := int64(z)
+	if good > bad {
+		v = m - v
+	}
+	if m < sample {
+		v = good - v
+	}
+	return v
+}
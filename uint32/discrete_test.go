@@ -0,0 +1,378 @@
+package random_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint32"
+)
+
+// testDiscreteDistribution tests the goodness of fit of the generated values to the given probability mass function
+// by Pearson's chi-squared test, at a significance level of falseFailureRate.
+// Adjacent values are gathered into bins so that the expected count of each bin is at least 5.
+func testDiscreteDistribution(
+	t *testing.T,
+	min, max int64,
+	pmf func(k int64) float64,
+	generate func(g random.Generator) int64,
+) {
+	testRng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	seed := testRng.Int63()
+	g := &testGenerator{rand.NewSource(seed).(rand.Source64)}
+	numSamples := 100000
+
+	histogram := make(map[int64]int)
+	for i := 0; i < numSamples; i++ {
+		v := generate(g)
+
+		assert.GreaterOrEqualf(t, v, min,
+			"v(%d) = %d should be greater than or equal to %d (seed = %d)", i, v, min, seed)
+		assert.LessOrEqualf(t, v, max,
+			"v(%d) = %d should be less than or equal to %d (seed = %d)", i, v, max, seed)
+
+		histogram[v]++
+	}
+
+	type bin struct {
+		count int
+		p     float64
+	}
+	var bins []bin
+	cur := bin{}
+	total := bin{}
+	for k := min; k <= max && total.p+cur.p < 1-1e-12; k++ {
+		cur.count += histogram[k]
+		cur.p += pmf(k)
+		if cur.p*float64(numSamples) >= 5 {
+			bins = append(bins, cur)
+			total.count += cur.count
+			total.p += cur.p
+			cur = bin{}
+		}
+	}
+	// the rest of the values, including those not enumerated, go into the last bin
+	rest := bin{count: numSamples - total.count, p: 1 - total.p}
+	if len(bins) > 0 && rest.p*float64(numSamples) < 5 {
+		last := &bins[len(bins)-1]
+		last.count += rest.count
+		last.p += rest.p
+	} else {
+		bins = append(bins, rest)
+	}
+	if len(bins) < 2 {
+		return // nothing to test if all the values fall into one bin
+	}
+
+	stat := 0.0
+	for _, b := range bins {
+		expected := b.p * float64(numSamples)
+		stat += (float64(b.count) - expected) * (float64(b.count) - expected) / expected
+	}
+
+	critical := chiSquaredCritical(float64(len(bins)-1), falseFailureRate)
+	assert.Lessf(t, stat, critical,
+		"chi-squared statistic = %f should be less than %f (seed = %d)", stat, critical, seed)
+}
+
+// chiSquaredCritical returns the upper alpha quantile of the chi-squared distribution with df degrees of freedom.
+func chiSquaredCritical(df, alpha float64) float64 {
+	lo, hi := 0.0, df+1
+	for upperRegularizedGamma(df/2, hi/2) > alpha {
+		lo, hi = hi, 2*hi
+	}
+	for i := 0; i < 100; i++ {
+		mid := (lo + hi) / 2
+		if upperRegularizedGamma(df/2, mid/2) > alpha {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi
+}
+
+// upperRegularizedGamma returns the regularized upper incomplete gamma function Q(a, x).
+// It uses the series expansion of P(a, x) for x < a + 1 and the continued fraction of Q(a, x) otherwise.
+func upperRegularizedGamma(a, x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	logPrefix := a*math.Log(x) - x - logGamma(a)
+	if x < a+1 {
+		term := 1 / a
+		sum := term
+		for n := 1.0; n < 1000; n++ {
+			term *= x / (a + n)
+			sum += term
+			if term < sum*1e-15 {
+				break
+			}
+		}
+		return 1 - sum*math.Exp(logPrefix)
+	}
+	// modified Lentz's method
+	const tiny = 1e-300
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for n := 1.0; n < 1000; n++ {
+		an := -n * (n - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < 1e-15 {
+			break
+		}
+	}
+	return h * math.Exp(logPrefix)
+}
+
+func logChoose(n, k int64) float64 {
+	return logGamma(float64(n+1)) - logGamma(float64(k+1)) - logGamma(float64(n-k+1))
+}
+
+func logGamma(x float64) float64 {
+	v, _ := math.Lgamma(x)
+	return v
+}
+
+func binomialPMF(n int64, p float64) func(k int64) float64 {
+	return func(k int64) float64 {
+		return math.Exp(logChoose(n, k) + float64(k)*math.Log(p) + float64(n-k)*math.Log1p(-p))
+	}
+}
+
+func poissonPMF(lambda float64) func(k int64) float64 {
+	return func(k int64) float64 {
+		return math.Exp(float64(k)*math.Log(lambda) - lambda - logGamma(float64(k+1)))
+	}
+}
+
+func geometricPMF(p float64) func(k int64) float64 {
+	return func(k int64) float64 {
+		return math.Exp(float64(k)*math.Log1p(-p)) * p
+	}
+}
+
+func negativeBinomialPMF(r, p float64) func(k int64) float64 {
+	return func(k int64) float64 {
+		fk := float64(k)
+		return math.Exp(logGamma(fk+r) - logGamma(fk+1) - logGamma(r) + r*math.Log(p) + fk*math.Log1p(-p))
+	}
+}
+
+func hypergeometricPMF(good, bad, sample int64) func(k int64) float64 {
+	return func(k int64) float64 {
+		if k > good || sample-k > bad {
+			return 0
+		}
+		return math.Exp(logChoose(good, k) + logChoose(bad, sample-k) - logChoose(good+bad, sample))
+	}
+}
+
+func asFloat64(generate func(g random.Generator) int64) func(g random.Generator) float64 {
+	return func(g random.Generator) float64 {
+		return float64(generate(g))
+	}
+}
+
+func TestBinomial(t *testing.T) {
+	t.Run("panics if n < 0 or p is not within [0, 1]", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Binomial(g, -1, 0.5) })
+		assert.Panics(t, func() { random.Binomial(g, 10, -0.1) })
+		assert.Panics(t, func() { random.Binomial(g, 10, 1.1) })
+		assert.Panics(t, func() { random.Binomial(g, 10, math.NaN()) })
+	})
+
+	t.Run("trivial cases", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Equal(t, int64(0), random.Binomial(g, 0, 0.5))
+		assert.Equal(t, int64(0), random.Binomial(g, 10, 0))
+		assert.Equal(t, int64(10), random.Binomial(g, 10, 1))
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) int64 {
+			return random.Binomial(g, 1000, 0.3)
+		})
+	})
+
+	for _, params := range []struct {
+		n int64
+		p float64
+	}{
+		{20, 0.3},    // inversion
+		{20, 0.9},    // inversion
+		{1000, 0.4},  // BTPE
+		{1000, 0.75}, // BTPE
+		{100, 0.5},   // BTPE
+	} {
+		n, p := params.n, params.p
+
+		t.Run(fmt.Sprintf("distribution (n = %d, p = %g)", n, p), func(t *testing.T) {
+			testDiscreteDistribution(t, 0, n, binomialPMF(n, p), func(g random.Generator) int64 {
+				return random.Binomial(g, n, p)
+			})
+		})
+	}
+
+	t.Run("moments of large n", func(t *testing.T) {
+		n, p := int64(1e12), 0.01
+		testMoments(t, float64(n)*p, float64(n)*p*(1-p), asFloat64(func(g random.Generator) int64 {
+			return random.Binomial(g, n, p)
+		}))
+	})
+}
+
+func TestPoisson(t *testing.T) {
+	t.Run("panics if lambda is not within [0, 9.2e18]", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Poisson(g, -1) })
+		assert.Panics(t, func() { random.Poisson(g, math.Inf(1)) })
+		assert.Panics(t, func() { random.Poisson(g, math.NaN()) })
+	})
+
+	t.Run("trivial cases", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Equal(t, int64(0), random.Poisson(g, 0))
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) int64 {
+			return random.Poisson(g, 25)
+		})
+	})
+
+	for _, lambda := range []float64{0.5, 3, 10, 50} {
+		lambda := lambda
+
+		t.Run(fmt.Sprintf("distribution (lambda = %g)", lambda), func(t *testing.T) {
+			testDiscreteDistribution(t, 0, math.MaxInt64, poissonPMF(lambda), func(g random.Generator) int64 {
+				return random.Poisson(g, lambda)
+			})
+		})
+	}
+
+	t.Run("moments of large lambda", func(t *testing.T) {
+		testMoments(t, 1e12, 1e12, asFloat64(func(g random.Generator) int64 {
+			return random.Poisson(g, 1e12)
+		}))
+	})
+}
+
+func TestGeometric(t *testing.T) {
+	t.Run("panics if p is not within (0, 1]", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Geometric(g, 0) })
+		assert.Panics(t, func() { random.Geometric(g, 1.1) })
+		assert.Panics(t, func() { random.Geometric(g, math.NaN()) })
+	})
+
+	t.Run("trivial cases", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Equal(t, int64(0), random.Geometric(g, 1))
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) int64 {
+			return random.Geometric(g, 0.2)
+		})
+	})
+
+	for _, p := range []float64{0.05, 0.2, 0.7} {
+		p := p
+
+		t.Run(fmt.Sprintf("distribution (p = %g)", p), func(t *testing.T) {
+			testDiscreteDistribution(t, 0, math.MaxInt64, geometricPMF(p), func(g random.Generator) int64 {
+				return random.Geometric(g, p)
+			})
+		})
+	}
+}
+
+func TestNegativeBinomial(t *testing.T) {
+	t.Run("panics if r <= 0 or p is not within (0, 1]", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.NegativeBinomial(g, 0, 0.5) })
+		assert.Panics(t, func() { random.NegativeBinomial(g, 1, 0) })
+		assert.Panics(t, func() { random.NegativeBinomial(g, 1, 1.1) })
+	})
+
+	t.Run("trivial cases", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Equal(t, int64(0), random.NegativeBinomial(g, 3, 1))
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) int64 {
+			return random.NegativeBinomial(g, 3.5, 0.4)
+		})
+	})
+
+	for _, params := range [][2]float64{{3.5, 0.4}, {1, 0.3}, {20, 0.8}} {
+		r, p := params[0], params[1]
+
+		t.Run(fmt.Sprintf("distribution (r = %g, p = %g)", r, p), func(t *testing.T) {
+			testDiscreteDistribution(t, 0, math.MaxInt64, negativeBinomialPMF(r, p), func(g random.Generator) int64 {
+				return random.NegativeBinomial(g, r, p)
+			})
+		})
+	}
+}
+
+func TestHypergeometric(t *testing.T) {
+	t.Run("panics if arguments are invalid", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Hypergeometric(g, -1, 10, 5) })
+		assert.Panics(t, func() { random.Hypergeometric(g, 10, -1, 5) })
+		assert.Panics(t, func() { random.Hypergeometric(g, 10, 10, -1) })
+		assert.Panics(t, func() { random.Hypergeometric(g, 10, 10, 21) })
+		assert.Panics(t, func() { random.Hypergeometric(g, math.MaxInt64, 1, 5) })
+	})
+
+	t.Run("trivial cases", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Equal(t, int64(0), random.Hypergeometric(g, 10, 10, 0))
+		assert.Equal(t, int64(10), random.Hypergeometric(g, 10, 10, 20))
+		assert.Equal(t, int64(0), random.Hypergeometric(g, 0, 10, 5))
+		assert.Equal(t, int64(5), random.Hypergeometric(g, 10, 0, 5))
+		assert.Equal(t, int64(15), random.Hypergeometric(g, 30, 0, 15))
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) int64 {
+			return random.Hypergeometric(g, 200, 300, 120)
+		})
+	})
+
+	for _, params := range [][3]int64{
+		{20, 30, 8},     // urn
+		{30, 20, 8},     // urn
+		{200, 300, 120}, // HRUA
+		{300, 200, 400}, // HRUA
+		{5, 1000, 50},   // HRUA
+	} {
+		good, bad, sample := params[0], params[1], params[2]
+
+		t.Run(fmt.Sprintf("distribution (good = %d, bad = %d, sample = %d)", good, bad, sample), func(t *testing.T) {
+			testDiscreteDistribution(t, 0, sample, hypergeometricPMF(good, bad, sample), func(g random.Generator) int64 {
+				return random.Hypergeometric(g, good, bad, sample)
+			})
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package random_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint32"
+)
+
+func TestDynamicCategorical(t *testing.T) {
+	t.Run("returns an error if weights are invalid", func(t *testing.T) {
+		for _, weights := range [][]float64{
+			{1, -1},
+			{1, math.NaN()},
+			{1, math.Inf(1)},
+			{math.MaxFloat64, math.MaxFloat64},
+		} {
+			_, err := random.NewDynamicCategorical(weights)
+			assert.ErrorIs(t, err, random.ErrInvalidArgument)
+		}
+	})
+
+	t.Run("Len, Weight and Total", func(t *testing.T) {
+		c, err := random.NewDynamicCategorical([]float64{1, 2, 3})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, c.Len())
+		assert.Equal(t, 2.0, c.Weight(1))
+		assert.Equal(t, 6.0, c.Total())
+	})
+
+	t.Run("Set, Add and Remove update weights", func(t *testing.T) {
+		c, err := random.NewDynamicCategorical([]float64{1, 2, 3})
+		assert.NoError(t, err)
+		c.Set(0, 4)
+		assert.Equal(t, 4.0, c.Weight(0))
+		assert.Equal(t, 9.0, c.Total())
+		c.Add(1, 0.5)
+		assert.Equal(t, 2.5, c.Weight(1))
+		assert.Equal(t, 9.5, c.Total())
+		c.Add(2, -1)
+		assert.Equal(t, 2.0, c.Weight(2))
+		assert.Equal(t, 8.5, c.Total())
+		c.Add(2, -3)
+		assert.Equal(t, 0.0, c.Weight(2))
+		assert.Equal(t, 6.5, c.Total())
+		c.Remove(0)
+		assert.Equal(t, 0.0, c.Weight(0))
+		assert.Equal(t, 2.5, c.Total())
+	})
+
+	t.Run("Push adds categories", func(t *testing.T) {
+		c, err := random.NewDynamicCategorical(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, c.Len())
+		assert.Equal(t, 0.0, c.Total())
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, i, c.Push(float64(i)))
+		}
+		assert.Equal(t, 10, c.Len())
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, float64(i), c.Weight(i))
+		}
+		assert.Equal(t, 45.0, c.Total())
+	})
+
+	t.Run("panics if arguments are invalid", func(t *testing.T) {
+		c, err := random.NewDynamicCategorical([]float64{1, 2, 3})
+		assert.NoError(t, err)
+		assert.Panics(t, func() { c.Weight(-1) })
+		assert.Panics(t, func() { c.Weight(3) })
+		assert.Panics(t, func() { c.Set(3, 1) })
+		assert.Panics(t, func() { c.Set(0, -1) })
+		assert.Panics(t, func() { c.Set(0, math.NaN()) })
+		assert.Panics(t, func() { c.Set(0, math.Inf(1)) })
+		assert.Panics(t, func() { c.Add(3, 1) })
+		assert.Panics(t, func() { c.Add(0, math.NaN()) })
+		assert.Panics(t, func() { c.Add(0, math.Inf(1)) })
+		assert.Panics(t, func() { c.Remove(3) })
+		assert.Panics(t, func() { c.Push(-1) })
+		assert.Panics(t, func() { c.Push(math.NaN()) })
+	})
+
+	t.Run("panics if the sum of weights is 0", func(t *testing.T) {
+		g := initTestGenerator()
+		c, err := random.NewDynamicCategorical(nil)
+		assert.NoError(t, err)
+		assert.Panics(t, func() { c.Sample(g) })
+		c.Push(0)
+		assert.Panics(t, func() { c.Sample(g) })
+	})
+
+	t.Run("never samples categories of zero weight", func(t *testing.T) {
+		c, err := random.NewDynamicCategorical([]float64{0, 1, 0, 2, 0})
+		assert.NoError(t, err)
+		g := initTestGenerator()
+		for i := 0; i < 1000; i++ {
+			v := c.Sample(g)
+			assert.True(t, v == 1 || v == 3)
+		}
+		c.Remove(3)
+		for i := 0; i < 1000; i++ {
+			assert.Equal(t, 1, c.Sample(g))
+		}
+	})
+
+	t.Run("never samples categories of zero weight even with rounding errors", func(t *testing.T) {
+		// with the largest draw, the remainder after subtracting the first weight exceeds the third weight due to
+		// rounding errors
+		c, err := random.NewDynamicCategorical([]float64{0.197, 0, 0.79, 0})
+		assert.NoError(t, err)
+		g := &sequenceGenerator{seq: []uint32{math.MaxUint32, math.MaxUint32}}
+		assert.Equal(t, 2, c.Sample(g))
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		c, err := random.NewDynamicCategorical([]float64{0.1, 0.2, 0.3, 0.4})
+		assert.NoError(t, err)
+		testSnapshot(t, c.Sample)
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		weights := []float64{0.5, 3, 0, 1.25, 8, 2, 0.01, 4}
+		sum := 0.0
+		for _, w := range weights {
+			sum += w
+		}
+		c, err := random.NewDynamicCategorical(weights)
+		assert.NoError(t, err)
+		testDiscreteDistribution(
+			t,
+			0, int64(len(weights)-1),
+			func(k int64) float64 {
+				return weights[k] / sum
+			},
+			func(g random.Generator) int64 {
+				return int64(c.Sample(g))
+			},
+		)
+	})
+
+	t.Run("distribution after updates", func(t *testing.T) {
+		c, err := random.NewDynamicCategorical([]float64{5, 5, 5})
+		assert.NoError(t, err)
+		c.Set(0, 1)
+		c.Add(1, -3)
+		c.Remove(2)
+		c.Push(3)
+		c.Push(0.5)
+		weights := []float64{1, 2, 0, 3, 0.5}
+		testDiscreteDistribution(
+			t,
+			0, int64(len(weights)-1),
+			func(k int64) float64 {
+				return weights[k] / 6.5
+			},
+			func(g random.Generator) int64 {
+				return int64(c.Sample(g))
+			},
+		)
+	})
+}
@@ -1,6 +1,10 @@
 package random
 
 // Generator is an abstract random number generator that yields uint32 values.
+//
+// Functions using rejection sampling draw values until one is accepted, so that
+// the results are unbiased.
+// They may never return if a broken generator keeps yielding the same value.
 type Generator interface {
 	Uint32() uint32
 }
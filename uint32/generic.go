@@ -0,0 +1,77 @@
+package random
+
+import (
+	"math/bits"
+	"reflect"
+	"unsafe"
+)
+
+// Signed is a constraint that permits any signed integer type.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a constraint that permits any unsigned integer type.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Between returns a random value of any integer type within the range [min, max].
+// It panics if min > max is given.
+//
+// For types of the same size, it generates the same values as the corresponding non-generic function
+// (e.g. Between[int64] and Int64Between).
+// For int, uint and uintptr, it generates the same values as IntBetween and UintBetween
+// on every platform.
+func Between[T Integer](g Generator, min, max T) T {
+	if min == max {
+		return min
+	} else if min < max {
+		if isPlatformSized(min) {
+			return T(uintAtMost(g, uint(max)-uint(min))) + min
+		} else if unsafe.Sizeof(min) <= 4 {
+			return T(uint32AtMost(g, uint32(max)-uint32(min))) + min
+		} else {
+			return T(uint64AtMost(g, uint64(max)-uint64(min))) + min
+		}
+	} else {
+		panic("invalid argument to Between: min must be less than or equal to max")
+	}
+}
+
+// isPlatformSized reports whether the type of v is based on int, uint or uintptr on a
+// 32-bit platform, where it has the same size as the 32-bit types but is generated in
+// the same way as the 64-bit ones.
+// It always returns false on 64-bit platforms, without inspecting the type.
+func isPlatformSized[T Integer](v T) bool {
+	if bits.UintSize == 64 {
+		return false
+	}
+	switch any(v).(type) {
+	case int, uint, uintptr:
+		return true
+	}
+	switch reflect.TypeOf(v).Kind() {
+	case reflect.Int, reflect.Uint, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
+
+// Float returns a random value of any floating-point type within the range [0, 1).
+//
+// It generates the same values as Float32 or Float64, depending on the size of the type.
+func Float[T ~float32 | ~float64](g Generator) T {
+	var v T
+	if unsafe.Sizeof(v) == 4 {
+		return T(Float32(g))
+	} else {
+		return T(Float64(g))
+	}
+}
@@ -0,0 +1,114 @@
+package random
+
+import "math"
+
+// Pareto returns a random float64 value that follows the Pareto (type I) distribution with the given scale (the
+// minimum value) and shape.
+// It panics if scale <= 0 or shape <= 0 is given.
+func Pareto(g Generator, scale, shape float64) float64 {
+	if !(scale > 0) {
+		panic("invalid argument to Pareto: scale must be greater than 0")
+	}
+	if !(shape > 0) {
+		panic("invalid argument to Pareto: shape must be greater than 0")
+	}
+	return scale * math.Exp(-math.Log(Float64Open(g))/shape)
+}
+
+// Lognormal returns a random float64 value whose logarithm follows the normal distribution with the given mean and
+// standard deviation.
+// It panics if stddev < 0 is given.
+func Lognormal(g Generator, mean, stddev float64) float64 {
+	if stddev >= 0 {
+		return math.Exp(mean + stddev*StdNormal(g))
+	} else {
+		panic("invalid argument to Lognormal: stddev must be greater than or equal to 0")
+	}
+}
+
+// Weibull returns a random float64 value that follows the Weibull distribution with the given scale and shape.
+// It panics if scale <= 0 or shape <= 0 is given.
+func Weibull(g Generator, scale, shape float64) float64 {
+	if !(scale > 0) {
+		panic("invalid argument to Weibull: scale must be greater than 0")
+	}
+	if !(shape > 0) {
+		panic("invalid argument to Weibull: shape must be greater than 0")
+	}
+	return scale * math.Pow(-math.Log(Float64Open(g)), 1/shape)
+}
+
+// Cauchy returns a random float64 value that follows the Cauchy distribution with the given location and scale.
+// It panics if scale <= 0 is given.
+func Cauchy(g Generator, location, scale float64) float64 {
+	if scale > 0 {
+		return location + scale*math.Tan(math.Pi*(Float64Open(g)-0.5))
+	} else {
+		panic("invalid argument to Cauchy: scale must be greater than 0")
+	}
+}
+
+// Laplace returns a random float64 value that follows the Laplace distribution with the given location and scale.
+// It panics if scale <= 0 is given.
+func Laplace(g Generator, location, scale float64) float64 {
+	if scale > 0 {
+		u := Float64Open(g) - 0.5
+		if u < 0 {
+			return location + scale*math.Log1p(2*u)
+		} else {
+			return location - scale*math.Log1p(-2*u)
+		}
+	} else {
+		panic("invalid argument to Laplace: scale must be greater than 0")
+	}
+}
+
+// Logistic returns a random float64 value that follows the logistic distribution with the given location and scale.
+// It panics if scale <= 0 is given.
+func Logistic(g Generator, location, scale float64) float64 {
+	if scale > 0 {
+		u := Float64Open(g)
+		return location + scale*math.Log(u/(1-u))
+	} else {
+		panic("invalid argument to Logistic: scale must be greater than 0")
+	}
+}
+
+// Gumbel returns a random float64 value that follows the Gumbel (type I extreme value) distribution with the given
+// location and scale.
+// It panics if scale <= 0 is given.
+func Gumbel(g Generator, location, scale float64) float64 {
+	if scale > 0 {
+		return location - scale*math.Log(-math.Log(Float64Open(g)))
+	} else {
+		panic("invalid argument to Gumbel: scale must be greater than 0")
+	}
+}
+
+// Frechet returns a random float64 value that follows the Fréchet (type II extreme value) distribution with the given
+// location, scale and shape.
+// It panics if scale <= 0 or shape <= 0 is given.
+func Frechet(g Generator, location, scale, shape float64) float64 {
+	if !(scale > 0) {
+		panic("invalid argument to Frechet: scale must be greater than 0")
+	}
+	if !(shape > 0) {
+		panic("invalid argument to Frechet: shape must be greater than 0")
+	}
+	return location + scale*math.Pow(-math.Log(Float64Open(g)), -1/shape)
+}
+
+// Levy returns a random float64 value that follows the Lévy distribution with the given location and scale.
+// It panics if scale <= 0 is given.
+func Levy(g Generator, location, scale float64) float64 {
+	if scale > 0 {
+		// StdNormal may return exactly 0, which would make the value infinite
+		z := StdNormal(g)
+		for z == 0 {
+			z = StdNormal(g)
+		}
+		return location + scale/(z*z)
+	} else {
+		panic("invalid argument to Levy: scale must be greater than 0")
+	}
+}
@@ -0,0 +1,295 @@
+package random_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint32"
+)
+
+func TestPareto(t *testing.T) {
+	t.Run("panics if scale <= 0 or shape <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Pareto(g, 0, 1) })
+		assert.Panics(t, func() { random.Pareto(g, 1, 0) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		skipArchDependentSnapshot(t)
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.Pareto(g, 2, 1.5)
+		})
+	})
+
+	t.Run("goodness of fit", func(t *testing.T) {
+		testKolmogorovSmirnov(
+			t,
+			func(x float64) float64 {
+				if x < 2 {
+					return 0
+				}
+				return 1 - math.Pow(2/x, 1.5)
+			},
+			func(g random.Generator) float64 {
+				return random.Pareto(g, 2, 1.5)
+			},
+		)
+	})
+}
+
+func TestLognormal(t *testing.T) {
+	t.Run("panics if stddev < 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Lognormal(g, 0, -1) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		skipArchDependentSnapshot(t)
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.Lognormal(g, 1, 0.5)
+		})
+	})
+
+	t.Run("moments", func(t *testing.T) {
+		mean := math.Exp(1 + 0.5*0.5/2)
+		variance := (math.Exp(0.5*0.5) - 1) * math.Exp(2*1+0.5*0.5)
+		testMoments(t, mean, variance, func(g random.Generator) float64 {
+			return random.Lognormal(g, 1, 0.5)
+		})
+	})
+
+	t.Run("goodness of fit", func(t *testing.T) {
+		testKolmogorovSmirnov(
+			t,
+			func(x float64) float64 {
+				if x <= 0 {
+					return 0
+				}
+				return normalCDF(1, 0.5)(math.Log(x))
+			},
+			func(g random.Generator) float64 {
+				return random.Lognormal(g, 1, 0.5)
+			},
+		)
+	})
+}
+
+func TestWeibull(t *testing.T) {
+	t.Run("panics if scale <= 0 or shape <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Weibull(g, 0, 1) })
+		assert.Panics(t, func() { random.Weibull(g, 1, 0) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		skipArchDependentSnapshot(t)
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.Weibull(g, 2, 0.7)
+		})
+	})
+
+	t.Run("goodness of fit", func(t *testing.T) {
+		testKolmogorovSmirnov(
+			t,
+			func(x float64) float64 {
+				if x <= 0 {
+					return 0
+				}
+				return 1 - math.Exp(-math.Pow(x/2, 0.7))
+			},
+			func(g random.Generator) float64 {
+				return random.Weibull(g, 2, 0.7)
+			},
+		)
+	})
+}
+
+func TestCauchy(t *testing.T) {
+	t.Run("panics if scale <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Cauchy(g, 0, 0) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.Cauchy(g, 1, 2)
+		})
+	})
+
+	t.Run("goodness of fit", func(t *testing.T) {
+		testKolmogorovSmirnov(
+			t,
+			func(x float64) float64 {
+				return 0.5 + math.Atan((x-1)/2)/math.Pi
+			},
+			func(g random.Generator) float64 {
+				return random.Cauchy(g, 1, 2)
+			},
+		)
+	})
+}
+
+func TestLaplace(t *testing.T) {
+	t.Run("panics if scale <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Laplace(g, 0, 0) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.Laplace(g, 1, 2)
+		})
+	})
+
+	t.Run("moments", func(t *testing.T) {
+		testMoments(t, 1, 2*2*2, func(g random.Generator) float64 {
+			return random.Laplace(g, 1, 2)
+		})
+	})
+
+	t.Run("goodness of fit", func(t *testing.T) {
+		testKolmogorovSmirnov(
+			t,
+			func(x float64) float64 {
+				if x < 1 {
+					return 0.5 * math.Exp((x-1)/2)
+				}
+				return 1 - 0.5*math.Exp(-(x-1)/2)
+			},
+			func(g random.Generator) float64 {
+				return random.Laplace(g, 1, 2)
+			},
+		)
+	})
+}
+
+func TestLogistic(t *testing.T) {
+	t.Run("panics if scale <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Logistic(g, 0, 0) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.Logistic(g, 1, 2)
+		})
+	})
+
+	t.Run("moments", func(t *testing.T) {
+		testMoments(t, 1, 2*2*math.Pi*math.Pi/3, func(g random.Generator) float64 {
+			return random.Logistic(g, 1, 2)
+		})
+	})
+
+	t.Run("goodness of fit", func(t *testing.T) {
+		testKolmogorovSmirnov(
+			t,
+			func(x float64) float64 {
+				return 1 / (1 + math.Exp(-(x-1)/2))
+			},
+			func(g random.Generator) float64 {
+				return random.Logistic(g, 1, 2)
+			},
+		)
+	})
+}
+
+func TestGumbel(t *testing.T) {
+	t.Run("panics if scale <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Gumbel(g, 0, 0) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.Gumbel(g, 1, 2)
+		})
+	})
+
+	t.Run("moments", func(t *testing.T) {
+		const eulerGamma = 0.5772156649015329
+		testMoments(t, 1+2*eulerGamma, math.Pi*math.Pi*2*2/6, func(g random.Generator) float64 {
+			return random.Gumbel(g, 1, 2)
+		})
+	})
+
+	t.Run("goodness of fit", func(t *testing.T) {
+		testKolmogorovSmirnov(
+			t,
+			func(x float64) float64 {
+				return math.Exp(-math.Exp(-(x - 1) / 2))
+			},
+			func(g random.Generator) float64 {
+				return random.Gumbel(g, 1, 2)
+			},
+		)
+	})
+}
+
+func TestFrechet(t *testing.T) {
+	t.Run("panics if scale <= 0 or shape <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Frechet(g, 0, 0, 1) })
+		assert.Panics(t, func() { random.Frechet(g, 0, 1, 0) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		skipArchDependentSnapshot(t)
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.Frechet(g, 1, 2, 3)
+		})
+	})
+
+	t.Run("goodness of fit", func(t *testing.T) {
+		testKolmogorovSmirnov(
+			t,
+			func(x float64) float64 {
+				if x <= 1 {
+					return 0
+				}
+				return math.Exp(-math.Pow((x-1)/2, -3))
+			},
+			func(g random.Generator) float64 {
+				return random.Frechet(g, 1, 2, 3)
+			},
+		)
+	})
+}
+
+func TestLevy(t *testing.T) {
+	t.Run("panics if scale <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Levy(g, 0, 0) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		skipArchDependentSnapshot(t)
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.Levy(g, 1, 2)
+		})
+	})
+
+	t.Run("does not return infinity if StdNormal returns 0", func(t *testing.T) {
+		assert.Zero(t, random.StdNormal(&sequenceGenerator{seq: []uint32{1, 0}}))
+		g := &sequenceGenerator{seq: []uint32{1, 0, 1, 1 << 31}}
+		z := random.StdNormal(&sequenceGenerator{seq: []uint32{1, 1 << 31}})
+		assert.NotZero(t, z)
+		assert.Equal(t, 1+2/(z*z), random.Levy(g, 1, 2))
+	})
+
+	t.Run("goodness of fit", func(t *testing.T) {
+		testKolmogorovSmirnov(
+			t,
+			func(x float64) float64 {
+				if x <= 1 {
+					return 0
+				}
+				return math.Erfc(math.Sqrt(2 / (2 * (x - 1))))
+			},
+			func(g random.Generator) float64 {
+				return random.Levy(g, 1, 2)
+			},
+		)
+	})
+}
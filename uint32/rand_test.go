@@ -0,0 +1,58 @@
+package random_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint32"
+)
+
+type seedableGenerator struct {
+	src rand.Source64
+}
+
+func (g *seedableGenerator) Uint32() uint32 {
+	return uint32(g.src.Uint64())
+}
+
+func (g *seedableGenerator) Seed(seed int64) {
+	g.src.Seed(seed)
+}
+
+func TestAsSource64(t *testing.T) {
+	t.Run("yields the values of Uint64", func(t *testing.T) {
+		src := random.AsSource64(&testGenerator{rand.NewSource(42).(rand.Source64)})
+		g := &testGenerator{rand.NewSource(42).(rand.Source64)}
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, random.Uint64(g), src.Uint64())
+		}
+	})
+
+	t.Run("Int63 drops the highest bit", func(t *testing.T) {
+		src := random.AsSource64(&testGenerator{rand.NewSource(42).(rand.Source64)})
+		g := &testGenerator{rand.NewSource(42).(rand.Source64)}
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, int64(random.Uint64(g)&(1<<63-1)), src.Int63())
+		}
+	})
+
+	t.Run("Seed calls Seed of the generator", func(t *testing.T) {
+		src := random.AsSource64(&seedableGenerator{rand.NewSource(0).(rand.Source64)})
+		src.Seed(42)
+		g := &testGenerator{rand.NewSource(42).(rand.Source64)}
+		assert.Equal(t, random.Uint64(g), src.Uint64())
+	})
+
+	t.Run("Seed panics if the generator cannot be seeded", func(t *testing.T) {
+		src := random.AsSource64(initTestGenerator())
+		assert.Panics(t, func() { src.Seed(42) })
+	})
+
+	t.Run("can be used with rand.New", func(t *testing.T) {
+		r := rand.New(random.AsSource64(initTestGenerator()))
+		v := r.Intn(10)
+		assert.GreaterOrEqual(t, v, 0)
+		assert.Less(t, v, 10)
+	})
+}
@@ -1,6 +1,8 @@
 package random
 
 import (
+	"errors"
+	"fmt"
 	"math"
 	"math/bits"
 )
@@ -45,25 +47,89 @@ func Uint64(g Generator) uint64 {
 	return (hi << 32) | lo
 }
 
+type bitmaskGenerator struct {
+	Generator
+}
+
+// WithBitmaskRejection wraps g so that the functions generating values within
+// bounded ranges use the bitmask rejection method, which was used before Lemire's
+// multiply-and-shift method was adopted.
+// It can be used to reproduce the sequences generated by earlier versions.
+//
+// The mode is kept if the result is wrapped by NewLockedGenerator.
+// It is not kept by FromUint64Generator or the shards of NewShardedGenerator,
+// so apply WithBitmaskRejection to the outermost generator,
+// e.g. WithBitmaskRejection(FromUint64Generator(g)).
+func WithBitmaskRejection(g Generator) Generator {
+	return &bitmaskGenerator{g}
+}
+
+func usesBitmaskRejection(g Generator) bool {
+	switch g := g.(type) {
+	case *bitmaskGenerator:
+		return true
+	case *LockedGenerator:
+		return usesBitmaskRejection(g.g)
+	default:
+		return false
+	}
+}
+
 // uintAtMost returns a random uint value within the range [0, max].
+// It draws uint64 values regardless of the size of uint so that the result does not
+// depend on it, except that the bitmask rejection method draws values of the size of
+// uint, in the same way as earlier versions.
 func uintAtMost(g Generator, max uint) uint {
-	if max == uint(math.MaxUint) {
-		return Uint(g)
-	} else if ((max + 1) & max) == 0 /* max like 0b11...1 */ {
-		return Uint(g) & max
+	if bits.UintSize <= 32 && usesBitmaskRejection(g) {
+		return uint(uint32AtMostBitmask(g, uint32(max)))
 	} else {
-		mask := uint(math.MaxUint) >> bits.LeadingZeros(max)
-		for {
-			v := Uint(g) & mask
-			if v <= max {
-				return v
-			}
-		}
+		return uint(uint64AtMost(g, uint64(max)))
 	}
 }
 
 // uint32AtMost returns a random uint32 value within the range [0, max].
+// It uses Lemire's nearly divisionless method (https://arxiv.org/abs/1805.10941).
 func uint32AtMost(g Generator, max uint32) uint32 {
+	if usesBitmaskRejection(g) {
+		return uint32AtMostBitmask(g, max)
+	}
+	if max == uint32(math.MaxUint32) {
+		return Uint32(g)
+	}
+	n := max + 1
+	hi, lo := bits.Mul32(Uint32(g), n)
+	if lo < n {
+		threshold := -n % n
+		for lo < threshold {
+			hi, lo = bits.Mul32(Uint32(g), n)
+		}
+	}
+	return hi
+}
+
+// uint64AtMost returns a random uint64 value within the range [0, max].
+// It uses Lemire's nearly divisionless method (https://arxiv.org/abs/1805.10941).
+func uint64AtMost(g Generator, max uint64) uint64 {
+	if usesBitmaskRejection(g) {
+		return uint64AtMostBitmask(g, max)
+	}
+	if max == uint64(math.MaxUint64) {
+		return Uint64(g)
+	}
+	n := max + 1
+	hi, lo := bits.Mul64(Uint64(g), n)
+	if lo < n {
+		threshold := -n % n
+		for lo < threshold {
+			hi, lo = bits.Mul64(Uint64(g), n)
+		}
+	}
+	return hi
+}
+
+// uint32AtMostBitmask returns a random uint32 value within the range [0, max],
+// using the bitmask rejection method.
+func uint32AtMostBitmask(g Generator, max uint32) uint32 {
 	if max == uint32(math.MaxUint32) {
 		return Uint32(g)
 	} else if ((max + 1) & max) == 0 /* max like 0b11...1 */ {
@@ -79,8 +145,9 @@ func uint32AtMost(g Generator, max uint32) uint32 {
 	}
 }
 
-// uint64AtMost returns a random uint64 value within the range [0, max].
-func uint64AtMost(g Generator, max uint64) uint64 {
+// uint64AtMostBitmask returns a random uint64 value within the range [0, max],
+// using the bitmask rejection method.
+func uint64AtMostBitmask(g Generator, max uint64) uint64 {
 	if max == uint64(math.MaxUint64) {
 		return Uint64(g)
 	} else if ((max + 1) & max) == 0 /* max like 0b11...1 */ {
@@ -104,7 +171,7 @@ func IntBetween(g Generator, min, max int) int {
 	} else if min < max {
 		return int(uintAtMost(g, uint(max)-uint(min))) + min
 	} else {
-		panic("invalid argument to IntBetween: min must be less than or equal to ma")
+		panic("invalid argument to IntBetween: min must be less than or equal to max")
 	}
 }
 
@@ -116,7 +183,7 @@ func Int32Between(g Generator, min, max int32) int32 {
 	} else if min < max {
 		return int32(uint32AtMost(g, uint32(max)-uint32(min))) + min
 	} else {
-		panic("invalid argument to Int32Between: min must be less than or equal to ma")
+		panic("invalid argument to Int32Between: min must be less than or equal to max")
 	}
 }
 
@@ -128,7 +195,7 @@ func Int64Between(g Generator, min, max int64) int64 {
 	} else if min < max {
 		return int64(uint64AtMost(g, uint64(max)-uint64(min))) + min
 	} else {
-		panic("invalid argument to Int64Between: min must be less than or equal to ma")
+		panic("invalid argument to Int64Between: min must be less than or equal to max")
 	}
 }
 
@@ -140,7 +207,7 @@ func UintBetween(g Generator, min, max uint) uint {
 	} else if min < max {
 		return uintAtMost(g, max-min) + min
 	} else {
-		panic("invalid argument to UintBetween: min must be less than or equal to ma")
+		panic("invalid argument to UintBetween: min must be less than or equal to max")
 	}
 }
 
@@ -152,7 +219,7 @@ func Uint32Between(g Generator, min, max uint32) uint32 {
 	} else if min < max {
 		return uint32AtMost(g, max-min) + min
 	} else {
-		panic("invalid argument to Uint32Between: min must be less than or equal to ma")
+		panic("invalid argument to Uint32Between: min must be less than or equal to max")
 	}
 }
 
@@ -164,7 +231,191 @@ func Uint64Between(g Generator, min, max uint64) uint64 {
 	} else if min < max {
 		return uint64AtMost(g, max-min) + min
 	} else {
-		panic("invalid argument to Uint64Between: min must be less than or equal to ma")
+		panic("invalid argument to Uint64Between: min must be less than or equal to max")
+	}
+}
+
+// IntN returns a random int value within the range [0, n).
+// It panics if n <= 0 is given.
+func IntN(g Generator, n int) int {
+	if n > 0 {
+		return int(uintAtMost(g, uint(n-1)))
+	} else {
+		panic("invalid argument to IntN: n must be greater than 0")
+	}
+}
+
+// Int32N returns a random int32 value within the range [0, n).
+// It panics if n <= 0 is given.
+func Int32N(g Generator, n int32) int32 {
+	if n > 0 {
+		return int32(uint32AtMost(g, uint32(n-1)))
+	} else {
+		panic("invalid argument to Int32N: n must be greater than 0")
+	}
+}
+
+// Int64N returns a random int64 value within the range [0, n).
+// It panics if n <= 0 is given.
+func Int64N(g Generator, n int64) int64 {
+	if n > 0 {
+		return int64(uint64AtMost(g, uint64(n-1)))
+	} else {
+		panic("invalid argument to Int64N: n must be greater than 0")
+	}
+}
+
+// UintN returns a random uint value within the range [0, n).
+// It panics if n == 0 is given.
+func UintN(g Generator, n uint) uint {
+	if n > 0 {
+		return uintAtMost(g, n-1)
+	} else {
+		panic("invalid argument to UintN: n must be greater than 0")
+	}
+}
+
+// Uint32N returns a random uint32 value within the range [0, n).
+// It panics if n == 0 is given.
+func Uint32N(g Generator, n uint32) uint32 {
+	if n > 0 {
+		return uint32AtMost(g, n-1)
+	} else {
+		panic("invalid argument to Uint32N: n must be greater than 0")
+	}
+}
+
+// Uint64N returns a random uint64 value within the range [0, n).
+// It panics if n == 0 is given.
+func Uint64N(g Generator, n uint64) uint64 {
+	if n > 0 {
+		return uint64AtMost(g, n-1)
+	} else {
+		panic("invalid argument to Uint64N: n must be greater than 0")
+	}
+}
+
+// ErrInvalidArgument is the error wrapped by the errors returned from the functions with the Err suffix,
+// when an invalid argument is given.
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// IntBetweenErr returns a random int value within the range [min, max].
+// Unlike IntBetween, it returns an error wrapping ErrInvalidArgument if min > max is given.
+func IntBetweenErr(g Generator, min, max int) (int, error) {
+	if min <= max {
+		return IntBetween(g, min, max), nil
+	} else {
+		return 0, fmt.Errorf("%w to IntBetweenErr: min must be less than or equal to max", ErrInvalidArgument)
+	}
+}
+
+// Int32BetweenErr returns a random int32 value within the range [min, max].
+// Unlike Int32Between, it returns an error wrapping ErrInvalidArgument if min > max is given.
+func Int32BetweenErr(g Generator, min, max int32) (int32, error) {
+	if min <= max {
+		return Int32Between(g, min, max), nil
+	} else {
+		return 0, fmt.Errorf("%w to Int32BetweenErr: min must be less than or equal to max", ErrInvalidArgument)
+	}
+}
+
+// Int64BetweenErr returns a random int64 value within the range [min, max].
+// Unlike Int64Between, it returns an error wrapping ErrInvalidArgument if min > max is given.
+func Int64BetweenErr(g Generator, min, max int64) (int64, error) {
+	if min <= max {
+		return Int64Between(g, min, max), nil
+	} else {
+		return 0, fmt.Errorf("%w to Int64BetweenErr: min must be less than or equal to max", ErrInvalidArgument)
+	}
+}
+
+// UintBetweenErr returns a random uint value within the range [min, max].
+// Unlike UintBetween, it returns an error wrapping ErrInvalidArgument if min > max is given.
+func UintBetweenErr(g Generator, min, max uint) (uint, error) {
+	if min <= max {
+		return UintBetween(g, min, max), nil
+	} else {
+		return 0, fmt.Errorf("%w to UintBetweenErr: min must be less than or equal to max", ErrInvalidArgument)
+	}
+}
+
+// Uint32BetweenErr returns a random uint32 value within the range [min, max].
+// Unlike Uint32Between, it returns an error wrapping ErrInvalidArgument if min > max is given.
+func Uint32BetweenErr(g Generator, min, max uint32) (uint32, error) {
+	if min <= max {
+		return Uint32Between(g, min, max), nil
+	} else {
+		return 0, fmt.Errorf("%w to Uint32BetweenErr: min must be less than or equal to max", ErrInvalidArgument)
+	}
+}
+
+// Uint64BetweenErr returns a random uint64 value within the range [min, max].
+// Unlike Uint64Between, it returns an error wrapping ErrInvalidArgument if min > max is given.
+func Uint64BetweenErr(g Generator, min, max uint64) (uint64, error) {
+	if min <= max {
+		return Uint64Between(g, min, max), nil
+	} else {
+		return 0, fmt.Errorf("%w to Uint64BetweenErr: min must be less than or equal to max", ErrInvalidArgument)
+	}
+}
+
+// IntNErr returns a random int value within the range [0, n).
+// Unlike IntN, it returns an error wrapping ErrInvalidArgument if n <= 0 is given.
+func IntNErr(g Generator, n int) (int, error) {
+	if n > 0 {
+		return IntN(g, n), nil
+	} else {
+		return 0, fmt.Errorf("%w to IntNErr: n must be greater than 0", ErrInvalidArgument)
+	}
+}
+
+// Int32NErr returns a random int32 value within the range [0, n).
+// Unlike Int32N, it returns an error wrapping ErrInvalidArgument if n <= 0 is given.
+func Int32NErr(g Generator, n int32) (int32, error) {
+	if n > 0 {
+		return Int32N(g, n), nil
+	} else {
+		return 0, fmt.Errorf("%w to Int32NErr: n must be greater than 0", ErrInvalidArgument)
+	}
+}
+
+// Int64NErr returns a random int64 value within the range [0, n).
+// Unlike Int64N, it returns an error wrapping ErrInvalidArgument if n <= 0 is given.
+func Int64NErr(g Generator, n int64) (int64, error) {
+	if n > 0 {
+		return Int64N(g, n), nil
+	} else {
+		return 0, fmt.Errorf("%w to Int64NErr: n must be greater than 0", ErrInvalidArgument)
+	}
+}
+
+// UintNErr returns a random uint value within the range [0, n).
+// Unlike UintN, it returns an error wrapping ErrInvalidArgument if n == 0 is given.
+func UintNErr(g Generator, n uint) (uint, error) {
+	if n > 0 {
+		return UintN(g, n), nil
+	} else {
+		return 0, fmt.Errorf("%w to UintNErr: n must be greater than 0", ErrInvalidArgument)
+	}
+}
+
+// Uint32NErr returns a random uint32 value within the range [0, n).
+// Unlike Uint32N, it returns an error wrapping ErrInvalidArgument if n == 0 is given.
+func Uint32NErr(g Generator, n uint32) (uint32, error) {
+	if n > 0 {
+		return Uint32N(g, n), nil
+	} else {
+		return 0, fmt.Errorf("%w to Uint32NErr: n must be greater than 0", ErrInvalidArgument)
+	}
+}
+
+// Uint64NErr returns a random uint64 value within the range [0, n).
+// Unlike Uint64N, it returns an error wrapping ErrInvalidArgument if n == 0 is given.
+func Uint64NErr(g Generator, n uint64) (uint64, error) {
+	if n > 0 {
+		return Uint64N(g, n), nil
+	} else {
+		return 0, fmt.Errorf("%w to Uint64NErr: n must be greater than 0", ErrInvalidArgument)
 	}
 }
 
@@ -178,6 +429,136 @@ func Float64(g Generator) float64 {
 	return float64(uint64AtMost(g, (1<<53)-1)) / (1 << 53)
 }
 
+// Float32Full returns a random float32 value within the range [0, 1).
+// Unlike Float32, it can return every representable value in the range, including tiny values near zero.
+// The result is a uniform real number in [0, 1) rounded down to a float32 value,
+// which is computed by drawing the exponent from a geometric distribution and the mantissa uniformly.
+func Float32Full(g Generator) float32 {
+	zeros := 0
+	r := Uint32(g)
+	for r == 0 {
+		zeros += 32
+		if zeros >= 149 {
+			return 0
+		}
+		r = Uint32(g)
+	}
+	lz := bits.LeadingZeros32(r)
+	zeros += lz
+	var m uint32
+	if lz <= 32-1-23 {
+		m = (r << (lz + 1)) >> (32 - 23)
+	} else {
+		m = Uint32(g) >> (32 - 23)
+	}
+	if zeros <= 125 {
+		return math.Float32frombits(uint32(126-zeros)<<23 | m)
+	} else {
+		return math.Float32frombits((1<<23 | m) >> (zeros - 125))
+	}
+}
+
+// Float64Full returns a random float64 value within the range [0, 1).
+// Unlike Float64, it can return every representable value in the range, including tiny values near zero.
+// The result is a uniform real number in [0, 1) rounded down to a float64 value,
+// which is computed by drawing the exponent from a geometric distribution and the mantissa uniformly.
+func Float64Full(g Generator) float64 {
+	zeros := 0
+	r := Uint64(g)
+	for r == 0 {
+		zeros += 64
+		if zeros >= 1074 {
+			return 0
+		}
+		r = Uint64(g)
+	}
+	lz := bits.LeadingZeros64(r)
+	zeros += lz
+	var m uint64
+	if lz <= 64-1-52 {
+		m = (r << (lz + 1)) >> (64 - 52)
+	} else {
+		m = Uint64(g) >> (64 - 52)
+	}
+	if zeros <= 1021 {
+		return math.Float64frombits(uint64(1022-zeros)<<52 | m)
+	} else {
+		return math.Float64frombits((1<<52 | m) >> (zeros - 1021))
+	}
+}
+
+// Float32Open returns a random float32 value within the range (0, 1).
+func Float32Open(g Generator) float32 {
+	return (float32(uint32AtMost(g, (1<<23)-1)) + 0.5) / (1 << 23)
+}
+
+// Float64Open returns a random float64 value within the range (0, 1).
+func Float64Open(g Generator) float64 {
+	return (float64(uint64AtMost(g, (1<<52)-1)) + 0.5) / (1 << 52)
+}
+
+// Float32Closed returns a random float32 value within the range [0, 1].
+func Float32Closed(g Generator) float32 {
+	return float32(uint32AtMost(g, 1<<24)) / (1 << 24)
+}
+
+// Float64Closed returns a random float64 value within the range [0, 1].
+func Float64Closed(g Generator) float64 {
+	return float64(uint64AtMost(g, 1<<53)) / (1 << 53)
+}
+
+// Float32Between returns a random float32 value within the range [min, max), or min if min == max.
+// It panics if min or max is not finite, or min > max is given.
+func Float32Between(g Generator, min, max float32) float32 {
+	if !isFinite(float64(min)) || !isFinite(float64(max)) {
+		panic("invalid argument to Float32Between: min and max must be finite")
+	}
+	if min == max {
+		return min
+	} else if min < max {
+		// the difference never overflows in float64
+		v := float32(float64(min) + float64(Float32(g))*(float64(max)-float64(min)))
+		if v >= max {
+			// rounding may reach max
+			v = math.Nextafter32(max, min)
+		}
+		return v
+	} else {
+		panic("invalid argument to Float32Between: min must be less than or equal to max")
+	}
+}
+
+// Float64Between returns a random float64 value within the range [min, max), or min if min == max.
+// It panics if min or max is not finite, or min > max is given.
+func Float64Between(g Generator, min, max float64) float64 {
+	if !isFinite(min) || !isFinite(max) {
+		panic("invalid argument to Float64Between: min and max must be finite")
+	}
+	if min == max {
+		return min
+	} else if min < max {
+		u := Float64(g)
+		var v float64
+		if d := max - min; !math.IsInf(d, 0) {
+			v = min + u*d
+		} else {
+			// compute with halves to avoid overflow of the difference
+			v = 2 * (min/2 + u*(max/2-min/2))
+		}
+		if v >= max {
+			// rounding may reach max
+			v = math.Nextafter(max, min)
+		}
+		return v
+	} else {
+		panic("invalid argument to Float64Between: min must be less than or equal to max")
+	}
+}
+
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
 // Bool returns a random bool value.
 func Bool(g Generator) bool {
 	return g.Uint32()&0x1 == 1
@@ -0,0 +1,14 @@
+//go:build go1.22
+
+package random
+
+import randv2 "math/rand/v2"
+
+// Any math/rand/v2.Source (e.g. rand.PCG and rand.ChaCha8) can be used as a Generator through FromUint64Generator.
+var _ Uint64Generator = (randv2.Source)(nil)
+
+// AsRandSource wraps a Generator as a math/rand/v2.Source, so that it can be used with rand.New.
+// Each uint64 value is made by Uint64.
+func AsRandSource(g Generator) randv2.Source {
+	return &source64{g}
+}
@@ -0,0 +1,180 @@
+package random
+
+import (
+	"container/heap"
+	"math"
+)
+
+// Reservoir maintains a uniform random sample of at most k items from a stream of unknown length.
+// It uses Li's Algorithm L, which draws values only for the items that enter the sample, so that adding n items takes
+// O(k (1 + log(n / k))) draws rather than the O(n) draws of Algorithm R.
+type Reservoir[T any] struct {
+	g     Generator
+	k     int
+	items []T
+	count int64
+	// w is the largest of the keys of the sampled items in Algorithm L.
+	w float64
+	// next is the index of the next item that enters the sample.
+	next int64
+}
+
+// NewReservoir creates a new Reservoir that samples at most k items, using the given generator.
+// It panics if k <= 0 is given.
+func NewReservoir[T any](g Generator, k int) *Reservoir[T] {
+	if k <= 0 {
+		panic("invalid argument to NewReservoir: k must be greater than 0")
+	}
+	return &Reservoir[T]{
+		g:     g,
+		k:     k,
+		items: make([]T, 0, k),
+	}
+}
+
+// Add adds an item from the stream.
+func (r *Reservoir[T]) Add(item T) {
+	i := r.count
+	r.count++
+	if len(r.items) < r.k {
+		r.items = append(r.items, item)
+		if len(r.items) == r.k {
+			r.w = math.Exp(math.Log(Float64Open(r.g)) / float64(r.k))
+			r.next = i
+			r.skip()
+		}
+	} else if i == r.next {
+		r.items[indexAtMost(r.g, r.k-1)] = item
+		r.w *= math.Exp(math.Log(Float64Open(r.g)) / float64(r.k))
+		r.skip()
+	}
+}
+
+// skip advances next by one plus the number of items to be skipped, which follows the geometric distribution with
+// success probability w.
+func (r *Reservoir[T]) skip() {
+	s := math.Floor(math.Log(Float64Open(r.g)) / math.Log1p(-r.w))
+	if s >= 1<<62 {
+		r.next = math.MaxInt64
+	} else {
+		r.next += int64(s) + 1
+		if r.next < 0 {
+			r.next = math.MaxInt64
+		}
+	}
+}
+
+// Count returns the number of the items added so far.
+func (r *Reservoir[T]) Count() int64 {
+	return r.count
+}
+
+// Items returns a copy of the sampled items, in unspecified order.
+// If fewer than k items have been added, it returns all of them.
+func (r *Reservoir[T]) Items() []T {
+	items := make([]T, len(r.items))
+	copy(items, r.items)
+	return items
+}
+
+// WeightedReservoir maintains a weighted random sample of at most k items from a stream of unknown length,
+// which has the same distribution as sampling without replacement with probabilities proportional to the weights.
+// It uses the A-ExpJ algorithm of Efraimidis and Spirakis, which skips items by their total weight so that only the
+// items that enter the sample consume draws.
+// The keys of the algorithm are kept in the log domain to avoid underflow for small weights.
+type WeightedReservoir[T any] struct {
+	g     Generator
+	k     int
+	items weightedItems[T]
+	count int64
+	// rest is the remaining weight of the items to be skipped.
+	rest float64
+}
+
+type weightedItem[T any] struct {
+	key  float64
+	item T
+}
+
+// weightedItems is a min-heap of items ordered by their keys.
+type weightedItems[T any] []weightedItem[T]
+
+func (h weightedItems[T]) Len() int           { return len(h) }
+func (h weightedItems[T]) Less(i, j int) bool { return h[i].key < h[j].key }
+func (h weightedItems[T]) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *weightedItems[T]) Push(x any) {
+	*h = append(*h, x.(weightedItem[T]))
+}
+
+func (h *weightedItems[T]) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// NewWeightedReservoir creates a new WeightedReservoir that samples at most k items, using the given generator.
+// It panics if k <= 0 is given.
+func NewWeightedReservoir[T any](g Generator, k int) *WeightedReservoir[T] {
+	if k <= 0 {
+		panic("invalid argument to NewWeightedReservoir: k must be greater than 0")
+	}
+	return &WeightedReservoir[T]{
+		g:     g,
+		k:     k,
+		items: make(weightedItems[T], 0, k),
+	}
+}
+
+// Add adds an item from the stream with the given weight.
+// Items of weight 0 are never sampled.
+// It panics if weight is negative or not finite.
+func (r *WeightedReservoir[T]) Add(item T, weight float64) {
+	if !(weight >= 0) || math.IsInf(weight, 0) {
+		panic("invalid argument to Add: weight must be non-negative and finite")
+	}
+	r.count++
+	if weight == 0 {
+		return
+	}
+	if len(r.items) < r.k {
+		// the key is log(u^(1/weight)) for u drawn uniformly from (0, 1)
+		heap.Push(&r.items, weightedItem[T]{key: math.Log(Float64Open(r.g)) / weight, item: item})
+		if len(r.items) == r.k {
+			r.skip()
+		}
+		return
+	}
+	r.rest -= weight
+	if r.rest > 0 {
+		return
+	}
+	// the key is drawn uniformly from (t, 1) in the linear domain, where t = exp(minKey)^weight
+	minKey := r.items[0].key
+	key := math.Log1p(math.Expm1(weight*minKey)*Float64Open(r.g)) / weight
+	r.items[0] = weightedItem[T]{key: key, item: item}
+	heap.Fix(&r.items, 0)
+	r.skip()
+}
+
+// skip draws the total weight of the items to be skipped before the next one enters the sample.
+func (r *WeightedReservoir[T]) skip() {
+	r.rest = math.Log(Float64Open(r.g)) / r.items[0].key
+}
+
+// Count returns the number of the items added so far, including the ones of weight 0.
+func (r *WeightedReservoir[T]) Count() int64 {
+	return r.count
+}
+
+// Items returns a copy of the sampled items, in unspecified order.
+// If fewer than k items of positive weight have been added, it returns all of them.
+func (r *WeightedReservoir[T]) Items() []T {
+	items := make([]T, len(r.items))
+	for i, x := range r.items {
+		items[i] = x.item
+	}
+	return items
+}
@@ -0,0 +1,217 @@
+package random_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint32"
+)
+
+func TestReservoir(t *testing.T) {
+	t.Run("panics if k <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.NewReservoir[int](g, 0) })
+		assert.Panics(t, func() { random.NewReservoir[int](g, -1) })
+	})
+
+	t.Run("keeps all items if fewer than k items are added", func(t *testing.T) {
+		g := initTestGenerator()
+		r := random.NewReservoir[int](g, 10)
+		assert.Empty(t, r.Items())
+		for i := 0; i < 10; i++ {
+			r.Add(i)
+		}
+		assert.Equal(t, identity(10), r.Items())
+		assert.Equal(t, int64(10), r.Count())
+	})
+
+	t.Run("keeps k items", func(t *testing.T) {
+		g := initTestGenerator()
+		r := random.NewReservoir[int](g, 10)
+		for i := 0; i < 1000; i++ {
+			r.Add(i)
+		}
+		items := r.Items()
+		assert.Len(t, items, 10)
+		seen := make(map[int]bool)
+		for _, v := range items {
+			assert.True(t, 0 <= v && v < 1000)
+			assert.False(t, seen[v])
+			seen[v] = true
+		}
+		assert.Equal(t, int64(1000), r.Count())
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) []int {
+			r := random.NewReservoir[int](g, 3)
+			for i := 0; i < 100; i++ {
+				r.Add(i)
+			}
+			return r.Items()
+		})
+	})
+
+	t.Run("distribution of ordered pairs", func(t *testing.T) {
+		testSmallIntegerUniformDistribution(t, 0, 29, func(g random.Generator) int {
+			r := random.NewReservoir[int](g, 3)
+			for i := 0; i < 6; i++ {
+				r.Add(i)
+			}
+			items := r.Items()
+			random.Shuffle(g, items)
+			return rankPair(6, items[0], items[1])
+		})
+	})
+
+	// every item of a long stream should be kept with the same probability; the items of a sample are distinct,
+	// which only makes the histogram more even, so that all of them are taken from each sample
+	t.Run("distribution of a long stream", func(t *testing.T) {
+		var items []int
+		testLargeIntegerUniformDistribution(t, 0, 9999, func(g random.Generator) int {
+			if len(items) == 0 {
+				r := random.NewReservoir[int](g, 10)
+				for i := 0; i < 10000; i++ {
+					r.Add(i)
+				}
+				items = r.Items()
+			}
+			v := items[0]
+			items = items[1:]
+			return v
+		})
+	})
+}
+
+func TestWeightedReservoir(t *testing.T) {
+	t.Run("panics if k <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.NewWeightedReservoir[int](g, 0) })
+		assert.Panics(t, func() { random.NewWeightedReservoir[int](g, -1) })
+	})
+
+	t.Run("panics if weight is invalid", func(t *testing.T) {
+		g := initTestGenerator()
+		r := random.NewWeightedReservoir[int](g, 10)
+		assert.Panics(t, func() { r.Add(0, -1) })
+		assert.Panics(t, func() { r.Add(0, math.NaN()) })
+		assert.Panics(t, func() { r.Add(0, math.Inf(1)) })
+	})
+
+	t.Run("keeps all items if fewer than k items are added", func(t *testing.T) {
+		g := initTestGenerator()
+		r := random.NewWeightedReservoir[int](g, 10)
+		assert.Empty(t, r.Items())
+		for i := 0; i < 10; i++ {
+			r.Add(i, float64(i+1))
+		}
+		assert.ElementsMatch(t, identity(10), r.Items())
+		assert.Equal(t, int64(10), r.Count())
+	})
+
+	t.Run("never samples items of zero weight", func(t *testing.T) {
+		g := initTestGenerator()
+		r := random.NewWeightedReservoir[int](g, 10)
+		for i := 0; i < 1000; i++ {
+			if i%2 == 0 {
+				r.Add(i, 0)
+			} else {
+				r.Add(i, 1)
+			}
+		}
+		items := r.Items()
+		assert.Len(t, items, 10)
+		for _, v := range items {
+			assert.Equal(t, 1, v%2)
+		}
+		assert.Equal(t, int64(1000), r.Count())
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) []int {
+			r := random.NewWeightedReservoir[int](g, 3)
+			for i := 0; i < 100; i++ {
+				r.Add(i, float64(i%10+1))
+			}
+			return r.Items()
+		})
+	})
+
+	t.Run("distribution of a single item", func(t *testing.T) {
+		weights := make([]float64, 50)
+		sum := 0.0
+		for i := range weights {
+			weights[i] = float64(i%5) + 0.5
+			sum += weights[i]
+		}
+		testDiscreteDistribution(
+			t,
+			0, int64(len(weights)-1),
+			func(k int64) float64 {
+				return weights[k] / sum
+			},
+			func(g random.Generator) int64 {
+				r := random.NewWeightedReservoir[int](g, 1)
+				for i, w := range weights {
+					r.Add(i, w)
+				}
+				return int64(r.Items()[0])
+			},
+		)
+	})
+
+	t.Run("distribution of pairs", func(t *testing.T) {
+		// the pair is sampled without replacement with probabilities proportional to the weights
+		weights := []float64{1, 2, 3, 4, 0.5, 8, 1, 2}
+		n := len(weights)
+		sum := 0.0
+		for _, w := range weights {
+			sum += w
+		}
+		testDiscreteDistribution(
+			t,
+			0, int64(n*(n-1)-1),
+			func(k int64) float64 {
+				i, j := int(k)/(n-1), int(k)%(n-1)
+				if j >= i {
+					j++
+				}
+				if i > j {
+					return 0
+				}
+				return weights[i]/sum*weights[j]/(sum-weights[i]) + weights[j]/sum*weights[i]/(sum-weights[j])
+			},
+			func(g random.Generator) int64 {
+				r := random.NewWeightedReservoir[int](g, 2)
+				for i, w := range weights {
+					r.Add(i, w)
+				}
+				items := r.Items()
+				i, j := items[0], items[1]
+				if i > j {
+					i, j = j, i
+				}
+				return int64(rankPair(n, i, j))
+			},
+		)
+	})
+
+	t.Run("distribution of huge weights", func(t *testing.T) {
+		weights := []float64{math.MaxFloat64 / 4, math.MaxFloat64 / 2, math.MaxFloat64 / 4}
+		testDiscreteDistribution(
+			t,
+			0, int64(len(weights)-1),
+			func(k int64) float64 {
+				return []float64{0.25, 0.5, 0.25}[k]
+			},
+			func(g random.Generator) int64 {
+				r := random.NewWeightedReservoir[int](g, 1)
+				for i, w := range weights {
+					r.Add(i, w)
+				}
+				return int64(r.Items()[0])
+			},
+		)
+	})
+}
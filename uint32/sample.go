@@ -0,0 +1,99 @@
+package random
+
+const (
+	// floydMaxK is the maximum k for which SampleIndices uses Floyd's algorithm.
+	floydMaxK = 16
+	// denseMaxRatio is the maximum n / k for which SampleIndices shuffles a slice of all the indices.
+	denseMaxRatio = 4
+)
+
+// SampleIndices returns k distinct random indices within the range [0, n), in random order.
+// All ordered samples are equally likely, and it takes O(k) time and space regardless of n.
+// It panics if n < 0, k < 0, or k > n is given.
+//
+// Like Shuffle, it generates the same indices regardless of the size of int on the platform.
+func SampleIndices(g Generator, n, k int) []int {
+	if n < 0 {
+		panic("invalid argument to SampleIndices: n must be greater than or equal to 0")
+	} else if k < 0 || k > n {
+		panic("invalid argument to SampleIndices: k must be within the range [0, n]")
+	}
+	if k <= floydMaxK {
+		return sampleIndicesFloyd(g, n, k)
+	} else if n/denseMaxRatio < k {
+		return sampleIndicesDense(g, n, k)
+	} else {
+		return sampleIndicesSparse(g, n, k)
+	}
+}
+
+// sampleIndicesFloyd samples indices with Floyd's algorithm, and then shuffles them.
+// It only takes k draws for the set of indices, and membership is checked by linear search since k is small.
+func sampleIndicesFloyd(g Generator, n, k int) []int {
+	s := make([]int, 0, k)
+	for j := n - k; j < n; j++ {
+		t := indexAtMost(g, j)
+		if containsIndex(s, t) {
+			s = append(s, j)
+		} else {
+			s = append(s, t)
+		}
+	}
+	Shuffle(g, s)
+	return s
+}
+
+func containsIndex(s []int, v int) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// sampleIndicesDense partially shuffles a slice of all the indices, which is cheaper than a map when k is close to n.
+func sampleIndicesDense(g Generator, n, k int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	PartialShuffle(g, s, k)
+	return s[:k:k]
+}
+
+// sampleIndicesSparse runs the first k steps of the Fisher–Yates algorithm on the virtual slice of all the indices,
+// keeping only the swapped positions in a map.
+func sampleIndicesSparse(g Generator, n, k int) []int {
+	s := make([]int, k)
+	swapped := make(map[int]int, k)
+	for i := 0; i < k; i++ {
+		j := i + indexAtMost(g, n-1-i)
+		vi, ok := swapped[i]
+		if !ok {
+			vi = i
+		}
+		vj, ok := swapped[j]
+		if !ok {
+			vj = j
+		}
+		s[i] = vj
+		swapped[j] = vi
+	}
+	return s
+}
+
+// Sample returns k distinct random elements of s, in random order, without modifying s.
+// Elements at different positions are considered distinct even if they are equal.
+// It panics if k < 0 or k > len(s) is given.
+func Sample[T any](g Generator, s []T, k int) []T {
+	if k < 0 || k > len(s) {
+		panic("invalid argument to Sample: k must be within the range [0, len(s)]")
+	}
+	indices := SampleIndices(g, len(s), k)
+	v := make([]T, k)
+	for i, j := range indices {
+		v[i] = s[j]
+	}
+	return v
+}
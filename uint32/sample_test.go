@@ -0,0 +1,138 @@
+package random_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint32"
+)
+
+// rankPair returns the rank of a pair of distinct values within the range [0, n) in lexicographic order,
+// which is within the range [0, n * (n - 1)).
+func rankPair(n, a, b int) int {
+	if b > a {
+		b--
+	}
+	return a*(n-1) + b
+}
+
+// eachIndex returns a function that returns the bin of each index within the range [0, n) returned by sample in turn,
+// where the range is divided into 8 bins.
+func eachIndex(sample func(g random.Generator) []int, n int) func(g random.Generator) int {
+	var buf []int
+	return func(g random.Generator) int {
+		if len(buf) == 0 {
+			buf = sample(g)
+		}
+		v := buf[0]
+		buf = buf[1:]
+		return v * 8 / n
+	}
+}
+
+func TestSampleIndices(t *testing.T) {
+	t.Run("panics if n < 0, k < 0, or k > n", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.SampleIndices(g, -1, 0) })
+		assert.Panics(t, func() { random.SampleIndices(g, 10, -1) })
+		assert.Panics(t, func() { random.SampleIndices(g, 10, 11) })
+	})
+
+	// each case uses a different algorithm, and n is divisible by 8 so that the bins below are of the same size
+	cases := []struct {
+		name string
+		n, k int
+	}{
+		{"small k", 1000000, 10},
+		{"k close to n", 96, 48},
+		{"large k", 1000000, 1000},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Run("returns distinct indices within the range", func(t *testing.T) {
+				g := initTestGenerator()
+				for i := 0; i < 10; i++ {
+					s := random.SampleIndices(g, c.n, c.k)
+					assert.Len(t, s, c.k)
+					seen := make(map[int]bool)
+					for _, v := range s {
+						assert.True(t, 0 <= v && v < c.n)
+						assert.False(t, seen[v])
+						seen[v] = true
+					}
+				}
+			})
+
+			t.Run("snapshot", func(t *testing.T) {
+				testSnapshot(t, func(g random.Generator) []int {
+					return random.SampleIndices(g, c.n, c.k)[:10]
+				})
+			})
+
+			// the indices of a sample are distinct, which only makes the histograms more even,
+			// so that several of them can be taken from one sample to save time
+			t.Run("distribution of the first indices", func(t *testing.T) {
+				testSmallIntegerUniformDistribution(t, 0, 7, eachIndex(func(g random.Generator) []int {
+					return random.SampleIndices(g, c.n, c.k)[:8]
+				}, c.n))
+			})
+
+			t.Run("distribution of the last indices", func(t *testing.T) {
+				testSmallIntegerUniformDistribution(t, 0, 7, eachIndex(func(g random.Generator) []int {
+					return random.SampleIndices(g, c.n, c.k)[c.k-8:]
+				}, c.n))
+			})
+		})
+	}
+
+	t.Run("returns an empty slice if k = 0", func(t *testing.T) {
+		g := &sequenceGenerator{}
+		assert.Empty(t, random.SampleIndices(g, 0, 0))
+		assert.Empty(t, random.SampleIndices(g, 10, 0))
+	})
+
+	t.Run("returns all indices if k = n", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.ElementsMatch(t, identity(10), random.SampleIndices(g, 10, 10))
+		assert.ElementsMatch(t, identity(100), random.SampleIndices(g, 100, 100))
+	})
+
+	t.Run("distribution of ordered pairs", func(t *testing.T) {
+		testSmallIntegerUniformDistribution(t, 0, 29, func(g random.Generator) int {
+			s := random.SampleIndices(g, 6, 2)
+			return rankPair(6, s[0], s[1])
+		})
+	})
+}
+
+func TestSample(t *testing.T) {
+	t.Run("panics if k < 0 or k > len(s)", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Sample(g, []string{"a", "b"}, -1) })
+		assert.Panics(t, func() { random.Sample(g, []string{"a", "b"}, 3) })
+	})
+
+	t.Run("returns the elements at the same indices as SampleIndices", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		s := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+		for i := 0; i < 100; i++ {
+			indices := random.SampleIndices(g2, len(s), 3)
+			assert.Equal(t, []string{s[indices[0]], s[indices[1]], s[indices[2]]}, random.Sample(g1, s, 3))
+		}
+	})
+
+	t.Run("does not modify the slice", func(t *testing.T) {
+		g := initTestGenerator()
+		s := identity(100)
+		random.Sample(g, s, 50)
+		assert.Equal(t, identity(100), s)
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) []string {
+			return random.Sample(g, []string{"a", "b", "c", "d", "e", "f", "g", "h"}, 3)
+		})
+	})
+}
@@ -0,0 +1,109 @@
+package random_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint32"
+)
+
+// rankPrefix returns the rank of the first k elements of a permutation of [0, len(s)) in lexicographic order,
+// which is within the range [0, len(s)! / (len(s) - k)!).
+func rankPrefix(s []int, k int) int {
+	n := len(s)
+	r := 0
+	for i := 0; i < k; i++ {
+		c := 0
+		for j := i + 1; j < n; j++ {
+			if s[j] < s[i] {
+				c++
+			}
+		}
+		r = r*(n-i) + c
+	}
+	return r
+}
+
+func identity(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
+
+func TestShuffle(t *testing.T) {
+	t.Run("permutes elements", func(t *testing.T) {
+		g := initTestGenerator()
+		s := identity(100)
+		random.Shuffle(g, s)
+		assert.ElementsMatch(t, identity(100), s)
+	})
+
+	t.Run("does not draw values for slices of length <= 1", func(t *testing.T) {
+		g := &sequenceGenerator{}
+		random.Shuffle(g, []int{})
+		random.Shuffle(g, []int{42})
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) []int {
+			s := identity(10)
+			random.Shuffle(g, s)
+			return s
+		})
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		testSmallIntegerUniformDistribution(t, 0, 23, func(g random.Generator) int {
+			s := identity(4)
+			random.Shuffle(g, s)
+			return rankPrefix(s, 4)
+		})
+	})
+}
+
+func TestPartialShuffle(t *testing.T) {
+	t.Run("panics if k < 0 or k > len(s)", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.PartialShuffle(g, identity(10), -1) })
+		assert.Panics(t, func() { random.PartialShuffle(g, identity(10), 11) })
+	})
+
+	t.Run("permutes elements", func(t *testing.T) {
+		g := initTestGenerator()
+		s := identity(100)
+		random.PartialShuffle(g, s, 10)
+		assert.ElementsMatch(t, identity(100), s)
+	})
+
+	t.Run("places the same elements as Shuffle", func(t *testing.T) {
+		for k := 0; k <= 10; k++ {
+			g1, g2 := initTestGeneratorPair()
+			s1 := identity(10)
+			s2 := identity(10)
+			random.PartialShuffle(g1, s1, k)
+			random.Shuffle(g2, s2)
+			assert.Equal(t, s2[:k], s1[:k])
+			if k == 10 {
+				assert.Equal(t, s2, s1)
+			}
+		}
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) []int {
+			s := identity(10)
+			random.PartialShuffle(g, s, 3)
+			return s[:3]
+		})
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		testSmallIntegerUniformDistribution(t, 0, 19, func(g random.Generator) int {
+			s := identity(5)
+			random.PartialShuffle(g, s, 2)
+			return rankPrefix(s, 2)
+		})
+	})
+}
@@ -0,0 +1,62 @@
+package random
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// LockedGenerator wraps a Generator with a mutex, so that it can be used from multiple goroutines.
+type LockedGenerator struct {
+	mu sync.Mutex
+	g  Generator
+}
+
+var _ Generator = (*LockedGenerator)(nil)
+
+// NewLockedGenerator creates a new LockedGenerator that wraps g.
+// g must not be used directly after wrapped.
+func NewLockedGenerator(g Generator) *LockedGenerator {
+	return &LockedGenerator{g: g}
+}
+
+// Uint32 returns a random uint32 value.
+func (l *LockedGenerator) Uint32() uint32 {
+	l.mu.Lock()
+	v := l.g.Uint32()
+	l.mu.Unlock()
+	return v
+}
+
+// ShardedGenerator is a generator that can be used from multiple goroutines with lower contention than
+// LockedGenerator.
+// It keeps a pool of generators (shards), and each draw is made by one of them that is not used by other goroutines.
+//
+// The sequence of values is not reproducible, because which shard is used for each draw depends on scheduling.
+type ShardedGenerator struct {
+	next uint64
+	pool sync.Pool
+}
+
+var _ Generator = (*ShardedGenerator)(nil)
+
+// NewShardedGenerator creates a new ShardedGenerator.
+// newShard is called whenever a new shard is needed, with a distinct index for each shard. It should return a
+// generator of an independent substream selected by the index, e.g. a PCG generator with the index as its stream, or
+// a counter-based generator with the index as a part of its key.
+// newShard may be called concurrently.
+func NewShardedGenerator(newShard func(i uint64) Generator) *ShardedGenerator {
+	s := &ShardedGenerator{}
+	s.pool.New = func() any {
+		i := atomic.AddUint64(&s.next, 1) - 1
+		return newShard(i)
+	}
+	return s
+}
+
+// Uint32 returns a random uint32 value.
+func (s *ShardedGenerator) Uint32() uint32 {
+	g := s.pool.Get().(Generator)
+	v := g.Uint32()
+	s.pool.Put(g)
+	return v
+}
@@ -0,0 +1,83 @@
+package random
+
+import "math"
+
+// BitSource generates random bits one at a time.
+// It caches a value generated by the underlying generator, and hands out its bits from the least significant one,
+// so that each value generated by the underlying generator yields 64 bits.
+//
+// BitSource is not safe for concurrent use.
+type BitSource struct {
+	g    Generator
+	bits uint64
+	n    int
+}
+
+// NewBitSource creates a new BitSource that generates bits using g.
+func NewBitSource(g Generator) *BitSource {
+	return &BitSource{g: g}
+}
+
+// Bool returns a random bool value.
+func (s *BitSource) Bool() bool {
+	if s.n == 0 {
+		s.bits = s.g.Uint64()
+		s.n = 64
+	}
+	b := s.bits&0x1 == 1
+	s.bits >>= 1
+	s.n--
+	return b
+}
+
+// Bernoulli returns true with probability p, and false otherwise.
+// It compares the bits of a uniform random real number with the binary expansion of p one at a time,
+// and consumes 2 bits on average.
+// The probability is exactly p, since every float64 value is a dyadic rational.
+// It panics if p is not within the range [0, 1].
+func (s *BitSource) Bernoulli(p float64) bool {
+	if !(p >= 0 && p <= 1) {
+		panic("invalid argument to Bernoulli: p must be within the range [0, 1]")
+	}
+	if p == 1 {
+		return true
+	}
+	for p > 0 {
+		p *= 2
+		pb := p >= 1
+		if pb {
+			p -= 1
+		}
+		if s.Bool() != pb {
+			return pb
+		}
+	}
+	return false
+}
+
+// Bernoulli returns true with probability p, and false otherwise.
+// It compares random uint64 values with the binary expansion of p 64 bits at a time,
+// and consumes just over one value on average.
+// The probability is exactly p, since every float64 value is a dyadic rational.
+// It panics if p is not within the range [0, 1].
+func Bernoulli(g Generator, p float64) bool {
+	if !(p >= 0 && p <= 1) {
+		panic("invalid argument to Bernoulli: p must be within the range [0, 1]")
+	}
+	if p == 1 {
+		return true
+	}
+	for p > 0 {
+		scaled := p * (1 << 64)
+		chunk := math.Floor(scaled)
+		c := uint64(chunk)
+		v := Uint64(g)
+		if v < c {
+			return true
+		} else if v > c {
+			return false
+		}
+		p = scaled - chunk
+	}
+	return false
+}
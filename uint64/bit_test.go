@@ -0,0 +1,150 @@
+package random_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint64"
+)
+
+func testBernoulliDistribution(t *testing.T, p float64, generate func(g random.Generator) bool) {
+	testRng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	seed := testRng.Int63()
+	g := rand.NewSource(seed).(rand.Source64)
+	numSamples := 20000
+
+	count := 0
+	for i := 0; i < numSamples; i++ {
+		if generate(g) {
+			count++
+		}
+	}
+
+	mean := float64(numSamples) * p
+	delta := 4 * math.Sqrt(float64(numSamples)*p*(1-p))
+	assert.InDeltaf(t, mean, count, delta,
+		"count = %d should be close to %f, (seed = %d)", count, mean, seed)
+}
+
+func TestBitSource(t *testing.T) {
+	t.Run("Bool returns bits from the least significant one", func(t *testing.T) {
+		s := random.NewBitSource(&sequenceGenerator{seq: []uint64{0x5, 0x1}})
+		assert.True(t, s.Bool())
+		assert.False(t, s.Bool())
+		assert.True(t, s.Bool())
+		for i := 3; i < 64; i++ {
+			assert.False(t, s.Bool())
+		}
+		assert.True(t, s.Bool())
+	})
+
+	t.Run("Bool consumes one value for every 64 bits", func(t *testing.T) {
+		g := &countingGenerator{g: initTestGenerator()}
+		s := random.NewBitSource(g)
+		for i := 0; i < 640; i++ {
+			s.Bool()
+		}
+		assert.Equal(t, 10, g.draws)
+	})
+
+	t.Run("Bool snapshot", func(t *testing.T) {
+		var s *random.BitSource
+		testSnapshot(t, func(g random.Generator) bool {
+			if s == nil {
+				s = random.NewBitSource(g)
+			}
+			return s.Bool()
+		})
+	})
+
+	t.Run("Bool distribution", func(t *testing.T) {
+		var s *random.BitSource
+		testBernoulliDistribution(t, 0.5, func(g random.Generator) bool {
+			if s == nil {
+				s = random.NewBitSource(g)
+			}
+			return s.Bool()
+		})
+	})
+
+	t.Run("Bernoulli panics if p is not within [0, 1]", func(t *testing.T) {
+		s := random.NewBitSource(initTestGenerator())
+		assert.Panics(t, func() { s.Bernoulli(-0.1) })
+		assert.Panics(t, func() { s.Bernoulli(1.1) })
+		assert.Panics(t, func() { s.Bernoulli(math.NaN()) })
+	})
+
+	t.Run("Bernoulli returns false if p = 0, and true if p = 1", func(t *testing.T) {
+		s := random.NewBitSource(initTestGenerator())
+		for i := 0; i < 100; i++ {
+			assert.False(t, s.Bernoulli(0))
+			assert.True(t, s.Bernoulli(1))
+		}
+	})
+
+	t.Run("Bernoulli compares bits with the binary expansion of p", func(t *testing.T) {
+		// p = 0.101b
+		s := random.NewBitSource(&sequenceGenerator{seq: []uint64{0x0}})
+		assert.True(t, s.Bernoulli(0.625)) // 0...
+		s = random.NewBitSource(&sequenceGenerator{seq: []uint64{0x1}})
+		assert.True(t, s.Bernoulli(0.625)) // 100...
+		s = random.NewBitSource(&sequenceGenerator{seq: []uint64{0x5}})
+		assert.False(t, s.Bernoulli(0.625)) // 101 (= p)
+		s = random.NewBitSource(&sequenceGenerator{seq: []uint64{0x3}})
+		assert.False(t, s.Bernoulli(0.625)) // 11...
+	})
+
+	t.Run("Bernoulli distribution", func(t *testing.T) {
+		var s *random.BitSource
+		testBernoulliDistribution(t, 0.3, func(g random.Generator) bool {
+			if s == nil {
+				s = random.NewBitSource(g)
+			}
+			return s.Bernoulli(0.3)
+		})
+	})
+}
+
+func TestBernoulli(t *testing.T) {
+	t.Run("panics if p is not within [0, 1]", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Bernoulli(g, -0.1) })
+		assert.Panics(t, func() { random.Bernoulli(g, 1.1) })
+		assert.Panics(t, func() { random.Bernoulli(g, math.NaN()) })
+	})
+
+	t.Run("returns false if p = 0, and true if p = 1", func(t *testing.T) {
+		g := initTestGenerator()
+		for i := 0; i < 100; i++ {
+			assert.False(t, random.Bernoulli(g, 0))
+			assert.True(t, random.Bernoulli(g, 1))
+		}
+	})
+
+	t.Run("is exact for dyadic p", func(t *testing.T) {
+		assert.True(t, random.Bernoulli(&sequenceGenerator{seq: []uint64{1<<63 - 1}}, 0.5))
+		assert.False(t, random.Bernoulli(&sequenceGenerator{seq: []uint64{1 << 63}}, 0.5))
+	})
+
+	t.Run("is exact for tiny p", func(t *testing.T) {
+		p := math.Ldexp(1, -70)
+		assert.True(t, random.Bernoulli(&sequenceGenerator{seq: []uint64{0, 1<<58 - 1}}, p))
+		assert.False(t, random.Bernoulli(&sequenceGenerator{seq: []uint64{0, 1 << 58}}, p))
+		assert.False(t, random.Bernoulli(&sequenceGenerator{seq: []uint64{1}}, p))
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) bool {
+			return random.Bernoulli(g, 0.3)
+		})
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		testBernoulliDistribution(t, 0.3, func(g random.Generator) bool {
+			return random.Bernoulli(g, 0.3)
+		})
+	})
+}
@@ -0,0 +1,24 @@
+package random
+
+// Uint32Generator is an abstract random number generator that yields uint32 values, i.e. the uint32 version of
+// Generator.
+type Uint32Generator interface {
+	Uint32() uint32
+}
+
+type uint32Bridge struct {
+	g Uint32Generator
+}
+
+// FromUint32Generator wraps a generator that yields uint32 values as a Generator.
+// Each value is made by combining two draws, the lower half first, in the same order as Uint64 of the uint32
+// version.
+func FromUint32Generator(g Uint32Generator) Generator {
+	return &uint32Bridge{g}
+}
+
+func (b *uint32Bridge) Uint64() uint64 {
+	lo := uint64(b.g.Uint32())
+	hi := uint64(b.g.Uint32())
+	return (hi << 32) | lo
+}
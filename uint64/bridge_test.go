@@ -0,0 +1,52 @@
+package random_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	random32 "github.com/susisu/go-random/uint32"
+	random "github.com/susisu/go-random/uint64"
+)
+
+type sequenceGenerator32 struct {
+	seq []uint32
+	pos int
+}
+
+func (g *sequenceGenerator32) Uint32() uint32 {
+	v := g.seq[g.pos]
+	g.pos++
+	return v
+}
+
+type uint32Generator struct {
+	g random.Generator
+}
+
+func (g *uint32Generator) Uint32() uint32 {
+	return uint32(g.g.Uint64())
+}
+
+func TestFromUint32Generator(t *testing.T) {
+	t.Run("does not keep the bitmask rejection mode of the uint32 version", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		a := random.FromUint32Generator(random32.WithBitmaskRejection(&uint32Generator{g1}))
+		b := random.FromUint32Generator(&uint32Generator{g2})
+		for i := 0; i < 100; i++ {
+			assert.Equal(t, random.IntBetween(b, 0, 100), random.IntBetween(a, 0, 100))
+		}
+	})
+
+	t.Run("combines two draws, the lower half first", func(t *testing.T) {
+		g := random.FromUint32Generator(&sequenceGenerator32{seq: []uint32{0x01234567, 0x89abcdef, 0xdeadbeef, 0xc0ffee}})
+		assert.Equal(t, uint64(0x89abcdef01234567), g.Uint64())
+		assert.Equal(t, uint64(0x00c0ffeedeadbeef), g.Uint64())
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		testLargeIntegerUniformDistribution(t, 0, math.MaxUint64, func(g random.Generator) uint64 {
+			return random.FromUint32Generator(&uint32Generator{g}).Uint64()
+		})
+	})
+}
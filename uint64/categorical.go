@@ -0,0 +1,189 @@
+package random
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// Categorical samples indices with probabilities proportional to the given float64 weights in O(1) time,
+// using Vose's alias method.
+type Categorical struct {
+	prob  []float64
+	alias []int
+}
+
+// NewCategorical creates a new Categorical that samples index i with probability weights[i] / sum(weights).
+// It returns an error wrapping ErrInvalidArgument if weights is empty, contains a negative or non-finite value, or
+// sums to 0.
+func NewCategorical(weights []float64) (*Categorical, error) {
+	n := len(weights)
+	if n == 0 {
+		return nil, fmt.Errorf("%w to NewCategorical: weights must not be empty", ErrInvalidArgument)
+	}
+	maxWeight := 0.0
+	for _, w := range weights {
+		if !(w >= 0) || math.IsInf(w, 0) {
+			return nil, fmt.Errorf("%w to NewCategorical: weights must be non-negative and finite", ErrInvalidArgument)
+		}
+		maxWeight = math.Max(maxWeight, w)
+	}
+	if maxWeight == 0 {
+		return nil, fmt.Errorf("%w to NewCategorical: sum of weights must be greater than 0", ErrInvalidArgument)
+	}
+	// normalize by the maximum weight so that the sum does not overflow
+	sum := 0.0
+	for _, w := range weights {
+		sum += w / maxWeight
+	}
+
+	p := make([]float64, n)
+	small := make([]int, 0, n)
+	large := make([]int, 0, n)
+	for i, w := range weights {
+		p[i] = w / maxWeight * float64(n) / sum
+		if p[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+
+	c := &Categorical{
+		prob:  make([]float64, n),
+		alias: make([]int, n),
+	}
+	for len(small) > 0 && len(large) > 0 {
+		s := small[len(small)-1]
+		small = small[:len(small)-1]
+		l := large[len(large)-1]
+		large = large[:len(large)-1]
+		c.prob[s] = p[s]
+		c.alias[s] = l
+		p[l] = (p[l] + p[s]) - 1
+		if p[l] < 1 {
+			small = append(small, l)
+		} else {
+			large = append(large, l)
+		}
+	}
+	// the remaining ones have p = 1, except for rounding errors
+	for _, i := range large {
+		c.prob[i] = 1
+		c.alias[i] = i
+	}
+	for _, i := range small {
+		c.prob[i] = 1
+		c.alias[i] = i
+	}
+	return c, nil
+}
+
+// Len returns the number of the categories.
+func (c *Categorical) Len() int {
+	return len(c.prob)
+}
+
+// Sample returns a random index within the range [0, c.Len()).
+func (c *Categorical) Sample(g Generator) int {
+	i := int(uintAtMost(g, uint(len(c.prob)-1)))
+	if Float64(g) < c.prob[i] {
+		return i
+	} else {
+		return c.alias[i]
+	}
+}
+
+// IntCategorical samples indices with probabilities proportional to the given integer weights in O(1) time,
+// using Vose's alias method.
+// Unlike Categorical, the probabilities are exact, because the table is built with integer arithmetic and sampled
+// with unbiased bounded integers.
+type IntCategorical struct {
+	total uint64
+	prob  []uint64
+	alias []int
+}
+
+// NewIntCategorical creates a new IntCategorical that samples index i with probability weights[i] / sum(weights).
+// It returns an error wrapping ErrInvalidArgument if weights is empty, contains a negative value, sums to 0, or
+// sum(weights) * len(weights) does not fit in uint64.
+func NewIntCategorical[T Integer](weights []T) (*IntCategorical, error) {
+	n := len(weights)
+	if n == 0 {
+		return nil, fmt.Errorf("%w to NewIntCategorical: weights must not be empty", ErrInvalidArgument)
+	}
+	var total uint64
+	for _, w := range weights {
+		if w < 0 {
+			return nil, fmt.Errorf("%w to NewIntCategorical: weights must be non-negative", ErrInvalidArgument)
+		}
+		var carry uint64
+		total, carry = bits.Add64(total, uint64(w), 0)
+		if carry != 0 {
+			return nil, fmt.Errorf("%w to NewIntCategorical: sum of weights must fit in uint64", ErrInvalidArgument)
+		}
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("%w to NewIntCategorical: sum of weights must be greater than 0", ErrInvalidArgument)
+	}
+	if hi, _ := bits.Mul64(total, uint64(n)); hi != 0 {
+		return nil, fmt.Errorf(
+			"%w to NewIntCategorical: sum of weights times the number of weights must fit in uint64",
+			ErrInvalidArgument,
+		)
+	}
+
+	// each bucket has capacity total, and category i has weights[i] * n in total
+	p := make([]uint64, n)
+	small := make([]int, 0, n)
+	large := make([]int, 0, n)
+	for i, w := range weights {
+		p[i] = uint64(w) * uint64(n)
+		if p[i] < total {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+
+	c := &IntCategorical{
+		total: total,
+		prob:  make([]uint64, n),
+		alias: make([]int, n),
+	}
+	for len(small) > 0 && len(large) > 0 {
+		s := small[len(small)-1]
+		small = small[:len(small)-1]
+		l := large[len(large)-1]
+		large = large[:len(large)-1]
+		c.prob[s] = p[s]
+		c.alias[s] = l
+		p[l] = (p[l] + p[s]) - total
+		if p[l] < total {
+			small = append(small, l)
+		} else {
+			large = append(large, l)
+		}
+	}
+	// the remaining ones have p = total exactly
+	for _, i := range large {
+		c.prob[i] = total
+		c.alias[i] = i
+	}
+	return c, nil
+}
+
+// Len returns the number of the categories.
+func (c *IntCategorical) Len() int {
+	return len(c.prob)
+}
+
+// Sample returns a random index within the range [0, c.Len()).
+func (c *IntCategorical) Sample(g Generator) int {
+	i := int(uintAtMost(g, uint(len(c.prob)-1)))
+	if uint64AtMost(g, c.total-1) < c.prob[i] {
+		return i
+	} else {
+		return c.alias[i]
+	}
+}
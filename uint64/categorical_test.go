@@ -0,0 +1,159 @@
+package random_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint64"
+)
+
+func TestCategorical(t *testing.T) {
+	t.Run("returns an error if weights are invalid", func(t *testing.T) {
+		for _, weights := range [][]float64{
+			{},
+			{1, -1},
+			{1, math.NaN()},
+			{1, math.Inf(1)},
+			{0, 0},
+		} {
+			_, err := random.NewCategorical(weights)
+			assert.ErrorIs(t, err, random.ErrInvalidArgument)
+		}
+	})
+
+	t.Run("Len", func(t *testing.T) {
+		c, err := random.NewCategorical([]float64{1, 2, 3})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, c.Len())
+	})
+
+	t.Run("never samples categories of zero weight", func(t *testing.T) {
+		c, err := random.NewCategorical([]float64{0, 1, 0, 2, 0})
+		assert.NoError(t, err)
+		g := initTestGenerator()
+		for i := 0; i < 1000; i++ {
+			v := c.Sample(g)
+			assert.True(t, v == 1 || v == 3)
+		}
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		c, err := random.NewCategorical([]float64{0.1, 0.2, 0.3, 0.4})
+		assert.NoError(t, err)
+		testSnapshot(t, c.Sample)
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		weights := []float64{0.5, 3, 0, 1.25, 8, 2, 0.01, 4}
+		sum := 0.0
+		for _, w := range weights {
+			sum += w
+		}
+		c, err := random.NewCategorical(weights)
+		assert.NoError(t, err)
+		testDiscreteDistribution(
+			t,
+			0, int64(len(weights)-1),
+			func(k int64) float64 {
+				return weights[k] / sum
+			},
+			func(g random.Generator) int64 {
+				return int64(c.Sample(g))
+			},
+		)
+	})
+
+	t.Run("distribution of huge weights", func(t *testing.T) {
+		weights := []float64{math.MaxFloat64, math.MaxFloat64 / 2, math.MaxFloat64 / 4}
+		c, err := random.NewCategorical(weights)
+		assert.NoError(t, err)
+		testDiscreteDistribution(
+			t,
+			0, int64(len(weights)-1),
+			func(k int64) float64 {
+				return []float64{4.0 / 7, 2.0 / 7, 1.0 / 7}[k]
+			},
+			func(g random.Generator) int64 {
+				return int64(c.Sample(g))
+			},
+		)
+	})
+}
+
+func TestIntCategorical(t *testing.T) {
+	t.Run("returns an error if weights are invalid", func(t *testing.T) {
+		for _, weights := range [][]int{
+			{},
+			{1, -1},
+			{0, 0},
+		} {
+			_, err := random.NewIntCategorical(weights)
+			assert.ErrorIs(t, err, random.ErrInvalidArgument)
+		}
+
+		_, err := random.NewIntCategorical([]uint64{math.MaxUint64, 1})
+		assert.ErrorIs(t, err, random.ErrInvalidArgument)
+
+		_, err = random.NewIntCategorical([]uint64{math.MaxUint64 / 2, 1})
+		assert.ErrorIs(t, err, random.ErrInvalidArgument)
+	})
+
+	t.Run("accepts named integer types", func(t *testing.T) {
+		c, err := random.NewIntCategorical([]level{1, 2, 3})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, c.Len())
+	})
+
+	t.Run("never samples categories of zero weight", func(t *testing.T) {
+		c, err := random.NewIntCategorical([]int{0, 1, 0, 2, 0})
+		assert.NoError(t, err)
+		g := initTestGenerator()
+		for i := 0; i < 1000; i++ {
+			v := c.Sample(g)
+			assert.True(t, v == 1 || v == 3)
+		}
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		c, err := random.NewIntCategorical([]int{1, 2, 3, 4})
+		assert.NoError(t, err)
+		testSnapshot(t, c.Sample)
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		weights := []int{1, 6, 0, 3, 16, 4, 1, 8}
+		sum := 0
+		for _, w := range weights {
+			sum += w
+		}
+		c, err := random.NewIntCategorical(weights)
+		assert.NoError(t, err)
+		testDiscreteDistribution(
+			t,
+			0, int64(len(weights)-1),
+			func(k int64) float64 {
+				return float64(weights[k]) / float64(sum)
+			},
+			func(g random.Generator) int64 {
+				return int64(c.Sample(g))
+			},
+		)
+	})
+
+	t.Run("distribution of huge weights", func(t *testing.T) {
+		weights := []uint64{math.MaxUint64 / 8, math.MaxUint64 / 16, math.MaxUint64 / 32}
+		c, err := random.NewIntCategorical(weights)
+		assert.NoError(t, err)
+		testDiscreteDistribution(
+			t,
+			0, int64(len(weights)-1),
+			func(k int64) float64 {
+				return []float64{4.0 / 7, 2.0 / 7, 1.0 / 7}[k]
+			},
+			func(g random.Generator) int64 {
+				return int64(c.Sample(g))
+			},
+		)
+	})
+}
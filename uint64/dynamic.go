@@ -0,0 +1,159 @@
+package random
+
+import (
+	"fmt"
+	"math"
+)
+
+// DynamicCategorical samples indices with probabilities proportional to weights that can be updated.
+// It keeps the weights in a segment tree, so that both updating a weight and sampling take O(log n) time.
+type DynamicCategorical struct {
+	n    int
+	size int
+	// tree[1] is the root, tree[size+i] is the weight of category i, and each of the other nodes is the sum of its
+	// children.
+	tree []float64
+}
+
+// NewDynamicCategorical creates a new DynamicCategorical with the given initial weights.
+// weights may be empty, and categories can be added later with Push.
+// It returns an error wrapping ErrInvalidArgument if weights contains a negative or non-finite value,
+// or the sum of the weights overflows.
+func NewDynamicCategorical(weights []float64) (*DynamicCategorical, error) {
+	for _, w := range weights {
+		if !isValidWeight(w) {
+			return nil, fmt.Errorf(
+				"%w to NewDynamicCategorical: weights must be non-negative and finite",
+				ErrInvalidArgument,
+			)
+		}
+	}
+	c := &DynamicCategorical{}
+	c.rebuild(weights, len(weights))
+	if math.IsInf(c.tree[1], 0) {
+		return nil, fmt.Errorf("%w to NewDynamicCategorical: sum of weights must be finite", ErrInvalidArgument)
+	}
+	return c, nil
+}
+
+func isValidWeight(w float64) bool {
+	return w >= 0 && !math.IsInf(w, 0)
+}
+
+// rebuild rebuilds the tree with room for at least capacity categories.
+func (c *DynamicCategorical) rebuild(weights []float64, capacity int) {
+	size := 1
+	for size < capacity {
+		size *= 2
+	}
+	c.n = len(weights)
+	c.size = size
+	c.tree = make([]float64, 2*size)
+	copy(c.tree[size:], weights)
+	for i := size - 1; i >= 1; i-- {
+		c.tree[i] = c.tree[2*i] + c.tree[2*i+1]
+	}
+}
+
+// Len returns the number of the categories.
+func (c *DynamicCategorical) Len() int {
+	return c.n
+}
+
+// Total returns the sum of the weights.
+func (c *DynamicCategorical) Total() float64 {
+	return c.tree[1]
+}
+
+// Weight returns the weight of category i.
+// It panics if i is out of range.
+func (c *DynamicCategorical) Weight(i int) float64 {
+	c.checkIndex("Weight", i)
+	return c.tree[c.size+i]
+}
+
+// Set sets the weight of category i to w.
+// It panics if i is out of range, or w is negative or not finite.
+func (c *DynamicCategorical) Set(i int, w float64) {
+	c.checkIndex("Set", i)
+	if !isValidWeight(w) {
+		panic("invalid argument to Set: weight must be non-negative and finite")
+	}
+	c.set(i, w)
+}
+
+// Add adds delta to the weight of category i.
+// delta can be negative, and the weight is clamped to 0 if it becomes negative.
+// It panics if i is out of range, or the weight becomes not finite.
+func (c *DynamicCategorical) Add(i int, delta float64) {
+	c.checkIndex("Add", i)
+	w := math.Max(c.tree[c.size+i]+delta, 0)
+	if !isValidWeight(w) {
+		panic("invalid argument to Add: weight must be finite")
+	}
+	c.set(i, w)
+}
+
+// Remove sets the weight of category i to 0, so that it is never sampled.
+// The index remains valid, and the weight can be set again by Set.
+// It panics if i is out of range.
+func (c *DynamicCategorical) Remove(i int) {
+	c.checkIndex("Remove", i)
+	c.set(i, 0)
+}
+
+// Push adds a new category with weight w, and returns its index.
+// It panics if w is negative or not finite.
+func (c *DynamicCategorical) Push(w float64) int {
+	if !isValidWeight(w) {
+		panic("invalid argument to Push: weight must be non-negative and finite")
+	}
+	if c.n == c.size {
+		c.rebuild(c.tree[c.size:c.size+c.n], 2*c.size)
+	}
+	i := c.n
+	c.n++
+	c.set(i, w)
+	return i
+}
+
+func (c *DynamicCategorical) checkIndex(name string, i int) {
+	if i < 0 || i >= c.n {
+		panic(fmt.Sprintf("invalid argument to %s: index %d out of range [0, %d)", name, i, c.n))
+	}
+}
+
+// set sets the weight and recomputes the sums of the ancestors from their children, so that rounding errors do not
+// accumulate over updates.
+func (c *DynamicCategorical) set(i int, w float64) {
+	k := c.size + i
+	c.tree[k] = w
+	for k > 1 {
+		k /= 2
+		c.tree[k] = c.tree[2*k] + c.tree[2*k+1]
+	}
+}
+
+// Sample returns a random index within the range [0, c.Len()), with probability proportional to its weight.
+// Categories of weight 0 are never sampled.
+// It panics if the sum of the weights is 0 or overflows.
+func (c *DynamicCategorical) Sample(g Generator) int {
+	if !(c.tree[1] > 0) {
+		panic("invalid state for Sample: sum of weights must be greater than 0")
+	} else if math.IsInf(c.tree[1], 0) {
+		panic("invalid state for Sample: sum of weights must be finite")
+	}
+	u := Float64(g) * c.tree[1]
+	k := 1
+	for k < c.size {
+		left := c.tree[2*k]
+		// go to the non-empty child if u is out of the range due to rounding errors
+		if (u < left && left > 0) || c.tree[2*k+1] == 0 {
+			k = 2 * k
+		} else {
+			u -= left
+			k = 2*k + 1
+		}
+	}
+	return k - c.size
+}
@@ -3,6 +3,10 @@ package random
 import "math/rand"
 
 // Generator is an abstract random number generator that yields uint64 values.
+//
+// Functions using rejection sampling draw values until one is accepted, so that
+// the results are unbiased.
+// They may never return if a broken generator keeps yielding the same value.
 type Generator interface {
 	Uint64() uint64
 }
@@ -0,0 +1,100 @@
+package random_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint64"
+)
+
+type userID int64
+
+type handle int
+
+type level uint8
+
+type ratio float64
+
+func TestBetween(t *testing.T) {
+	t.Run("panics if min > max", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Between[int8](g, -127, -128) })
+		assert.Panics(t, func() { random.Between[userID](g, -127, -128) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) userID {
+			return random.Between[userID](g, -128, 127)
+		})
+	})
+
+	t.Run("generates the same values as the non-generic functions", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		for i := 0; i < 100; i++ {
+			assert.Equal(t, random.IntBetween(g2, -128, 127), random.Between(g1, -128, 127))
+			assert.Equal(t, random.Int32Between(g2, -128, 127), random.Between[int32](g1, -128, 127))
+			assert.Equal(t, userID(random.Int64Between(g2, -128, 127)), random.Between[userID](g1, -128, 127))
+			assert.Equal(t, random.UintBetween(g2, 0, 256), random.Between[uint](g1, 0, 256))
+			assert.Equal(t, random.Uint32Between(g2, 0, 256), random.Between[uint32](g1, 0, 256))
+			assert.Equal(t, random.Uint64Between(g2, 0, 256), random.Between[uint64](g1, 0, 256))
+			assert.Equal(
+				t,
+				time.Duration(random.Int64Between(g2, 0, int64(time.Second))),
+				random.Between(g1, 0, time.Second),
+			)
+		}
+	})
+
+	t.Run("large distribution", func(t *testing.T) {
+		testLargeIntegerUniformDistribution(t, math.MinInt8, math.MaxInt8, func(g random.Generator) int8 {
+			return random.Between[int8](g, math.MinInt8, math.MaxInt8)
+		})
+	})
+
+	t.Run("small distribution", func(t *testing.T) {
+		testSmallIntegerUniformDistribution(t, 2, 9, func(g random.Generator) level {
+			return random.Between[level](g, 2, 9)
+		})
+	})
+}
+
+func TestFloat(t *testing.T) {
+	t.Run("generates the same values as the non-generic functions", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		for i := 0; i < 100; i++ {
+			assert.Equal(t, random.Float32(g2), random.Float[float32](g1))
+			assert.Equal(t, random.Float64(g2), random.Float[float64](g1))
+			assert.Equal(t, ratio(random.Float64(g2)), random.Float[ratio](g1))
+		}
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		testRealUniformDistribution(t, 0, 1.0, random.Float[ratio])
+	})
+}
+
+// TestBetweenPlatformSized matters on 32-bit platforms, where int has the same size as int32.
+func TestBetweenPlatformSized(t *testing.T) {
+	t.Run("generates the same values as IntBetween and UintBetween", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		for i := 0; i < 100; i++ {
+			assert.Equal(t, random.IntBetween(g2, -1000, 1000), random.Between[int](g1, -1000, 1000))
+			assert.Equal(t, handle(random.IntBetween(g2, -1000, 1000)), random.Between[handle](g1, -1000, 1000))
+			assert.Equal(t, random.UintBetween(g2, 0, 1000), random.Between[uint](g1, 0, 1000))
+			assert.Equal(t, uintptr(random.UintBetween(g2, 0, 1000)), random.Between[uintptr](g1, 0, 1000))
+		}
+	})
+
+	t.Run("generates the same values as IntBetween and UintBetween with bitmask rejection", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		b1, b2 := random.WithBitmaskRejection(g1), random.WithBitmaskRejection(g2)
+		for i := 0; i < 100; i++ {
+			assert.Equal(t, random.IntBetween(b2, -1000, 1000), random.Between[int](b1, -1000, 1000))
+			assert.Equal(t, handle(random.IntBetween(b2, -1000, 1000)), random.Between[handle](b1, -1000, 1000))
+			assert.Equal(t, random.UintBetween(b2, 0, 1000), random.Between[uint](b1, 0, 1000))
+			assert.Equal(t, uintptr(random.UintBetween(b2, 0, 1000)), random.Between[uintptr](b1, 0, 1000))
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package random
+
+import "math"
+
+// Parameters of the Ziggurat method with 256 layers, from G. Marsaglia and W. W. Tsang,
+// "The Ziggurat Method for Generating Random Variables" (2000).
+const (
+	zigguratNormalR = 3.6541528853610088 // the start of the tail
+	zigguratNormalV = 0.00492867323399   // the area of each layer
+)
+
+// zigguratNormalX[i] is the width of the layer i, where zigguratNormalX[0] is the virtual width of the base layer
+// (including the tail), and zigguratNormalF[i] is the density at zigguratNormalX[i].
+var (
+	zigguratNormalX [257]float64
+	zigguratNormalF [257]float64
+)
+
+func init() {
+	f := func(x float64) float64 { return math.Exp(-0.5 * x * x) }
+	zigguratNormalX[0] = zigguratNormalV / f(zigguratNormalR)
+	zigguratNormalX[1] = zigguratNormalR
+	for i := 1; i < 256; i++ {
+		x := zigguratNormalX[i]
+		zigguratNormalX[i+1] = math.Sqrt(-2 * math.Log(zigguratNormalV/x+f(x)))
+	}
+	zigguratNormalX[256] = 0
+	for i := range zigguratNormalX {
+		zigguratNormalF[i] = f(zigguratNormalX[i])
+	}
+}
+
+// StdNormal returns a random float64 value that follows the standard normal distribution
+// (with mean 0 and standard deviation 1).
+// It uses the Ziggurat method.
+func StdNormal(g Generator) float64 {
+	for {
+		u := Uint64(g)
+		i := u & 0xff
+		neg := (u>>8)&0x1 == 1
+		x := float64(u>>11) / (1 << 53) * zigguratNormalX[i]
+		if x < zigguratNormalX[i+1] {
+			// inside the rectangle covered by the upper layer
+			return applySign(x, neg)
+		}
+		if i == 0 {
+			// the tail
+			for {
+				a := -math.Log(Float64Open(g)) / zigguratNormalR
+				b := -math.Log(Float64Open(g))
+				if 2*b >= a*a {
+					return applySign(zigguratNormalR+a, neg)
+				}
+			}
+		}
+		// the wedge
+		y := zigguratNormalF[i] + Float64(g)*(zigguratNormalF[i+1]-zigguratNormalF[i])
+		if y < math.Exp(-0.5*x*x) {
+			return applySign(x, neg)
+		}
+	}
+}
+
+func applySign(x float64, neg bool) float64 {
+	if neg {
+		return -x
+	} else {
+		return x
+	}
+}
+
+// Normal returns a random float64 value that follows the normal distribution with the given mean and standard
+// deviation.
+// It panics if stddev < 0 is given.
+func Normal(g Generator, mean, stddev float64) float64 {
+	if stddev >= 0 {
+		return mean + stddev*StdNormal(g)
+	} else {
+		panic("invalid argument to Normal: stddev must be greater than or equal to 0")
+	}
+}
@@ -0,0 +1,139 @@
+package random_test
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint64"
+)
+
+// falseFailureRate is the probability that a statistical test fails even if the values follow the expected
+// distribution.
+const falseFailureRate = 1e-6
+
+// testMoments tests that the mean and the variance of the generated values are close to the given ones.
+// Each of them is tested at a significance level of falseFailureRate / 2, using the normal approximation.
+func testMoments(t *testing.T, mean, variance float64, generate func(g random.Generator) float64) {
+	var seed int64 = 0x5eed // fixed so that failures are reproducible
+	g := rand.NewSource(seed).(rand.Source64)
+	numSamples := 100000
+
+	samples := make([]float64, numSamples)
+	sum := 0.0
+	for i := range samples {
+		v := generate(g)
+		samples[i] = v
+		sum += v
+	}
+	m := sum / float64(numSamples)
+	var m2, m4 float64
+	for _, v := range samples {
+		d := (v - m) * (v - m)
+		m2 += d
+		m4 += d * d
+	}
+	m2 /= float64(numSamples)
+	m4 /= float64(numSamples)
+
+	z := math.Sqrt2 * math.Erfinv(1-falseFailureRate/4)
+	meanDelta := z * math.Sqrt(variance/float64(numSamples))
+	assert.InDeltaf(t, mean, m, meanDelta,
+		"mean = %f should be close to %f (seed = %d)", m, mean, seed)
+	varianceDelta := z * math.Sqrt((m4-m2*m2)/float64(numSamples))
+	assert.InDeltaf(t, variance, m2, varianceDelta,
+		"variance = %f should be close to %f (seed = %d)", m2, variance, seed)
+}
+
+// testKolmogorovSmirnov tests the goodness of fit of the generated values to the given cumulative distribution function
+// by the Kolmogorov–Smirnov test, at a significance level of falseFailureRate.
+func testKolmogorovSmirnov(t *testing.T, cdf func(x float64) float64, generate func(g random.Generator) float64) {
+	var seed int64 = 0x5eed // fixed so that failures are reproducible
+	g := rand.NewSource(seed).(rand.Source64)
+	numSamples := 10000
+
+	samples := make([]float64, numSamples)
+	for i := range samples {
+		samples[i] = generate(g)
+	}
+	sort.Float64s(samples)
+
+	d := 0.0
+	for i, v := range samples {
+		c := cdf(v)
+		d = math.Max(d, math.Max(c-float64(i)/float64(numSamples), float64(i+1)/float64(numSamples)-c))
+	}
+
+	// the Dvoretzky–Kiefer–Wolfowitz inequality bounds the probability of exceeding it for any number of samples
+	critical := math.Sqrt(math.Log(2/falseFailureRate)/2) / math.Sqrt(float64(numSamples))
+	assert.Lessf(t, d, critical,
+		"Kolmogorov–Smirnov statistic = %f should be less than %f (seed = %d)", d, critical, seed)
+}
+
+func normalCDF(mean, stddev float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		return 0.5 * math.Erfc(-(x-mean)/(stddev*math.Sqrt2))
+	}
+}
+
+func TestStdNormal(t *testing.T) {
+	t.Run("snapshot", func(t *testing.T) {
+		skipArchDependentSnapshot(t)
+		testSnapshot(t, random.StdNormal)
+	})
+
+	t.Run("moments", func(t *testing.T) {
+		testMoments(t, 0, 1, random.StdNormal)
+	})
+
+	t.Run("goodness of fit", func(t *testing.T) {
+		testKolmogorovSmirnov(t, normalCDF(0, 1), random.StdNormal)
+	})
+
+	t.Run("goodness of fit in the tail", func(t *testing.T) {
+		// values greater than 3 include those sampled from the tail beyond 3.654...
+		const x0 = 3.0
+		p0 := 1 - normalCDF(0, 1)(x0)
+		testKolmogorovSmirnov(
+			t,
+			func(x float64) float64 {
+				return 1 - (1-normalCDF(0, 1)(x))/p0
+			},
+			func(g random.Generator) float64 {
+				for {
+					if v := math.Abs(random.StdNormal(g)); v > x0 {
+						return v
+					}
+				}
+			},
+		)
+	})
+}
+
+func TestNormal(t *testing.T) {
+	t.Run("panics if stddev < 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Normal(g, 0, -1) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		skipArchDependentSnapshot(t)
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.Normal(g, 10, 2.5)
+		})
+	})
+
+	t.Run("moments", func(t *testing.T) {
+		testMoments(t, 10, 2.5*2.5, func(g random.Generator) float64 {
+			return random.Normal(g, 10, 2.5)
+		})
+	})
+
+	t.Run("goodness of fit", func(t *testing.T) {
+		testKolmogorovSmirnov(t, normalCDF(10, 2.5), func(g random.Generator) float64 {
+			return random.Normal(g, 10, 2.5)
+		})
+	})
+}
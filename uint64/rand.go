@@ -0,0 +1,29 @@
+package random
+
+import "math/rand"
+
+type source64 struct {
+	g Generator
+}
+
+// AsSource64 wraps a Generator as a math/rand.Source64, so that it can be used with rand.New.
+// Seed of the returned source calls Seed(int64) of g if g has one, and panics otherwise.
+func AsSource64(g Generator) rand.Source64 {
+	return &source64{g}
+}
+
+func (s *source64) Uint64() uint64 {
+	return s.g.Uint64()
+}
+
+func (s *source64) Int63() int64 {
+	return int64(s.g.Uint64() & (1<<63 - 1))
+}
+
+func (s *source64) Seed(seed int64) {
+	if sg, ok := s.g.(interface{ Seed(int64) }); ok {
+		sg.Seed(seed)
+	} else {
+		panic("Seed is not supported: the underlying generator does not have Seed(int64)")
+	}
+}
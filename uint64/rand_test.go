@@ -0,0 +1,54 @@
+package random_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint64"
+)
+
+type unseedableGenerator struct {
+	g random.Generator
+}
+
+func (g *unseedableGenerator) Uint64() uint64 {
+	return g.g.Uint64()
+}
+
+func TestAsSource64(t *testing.T) {
+	t.Run("yields the values of the generator", func(t *testing.T) {
+		src := random.AsSource64(rand.NewSource(42).(rand.Source64))
+		g := rand.NewSource(42).(rand.Source64)
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, g.Uint64(), src.Uint64())
+		}
+	})
+
+	t.Run("Int63 drops the highest bit", func(t *testing.T) {
+		src := random.AsSource64(rand.NewSource(42).(rand.Source64))
+		g := rand.NewSource(42).(rand.Source64)
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, int64(g.Uint64()&(1<<63-1)), src.Int63())
+		}
+	})
+
+	t.Run("Seed calls Seed of the generator", func(t *testing.T) {
+		src := random.AsSource64(rand.NewSource(0).(rand.Source64))
+		src.Seed(42)
+		g := rand.NewSource(42).(rand.Source64)
+		assert.Equal(t, g.Uint64(), src.Uint64())
+	})
+
+	t.Run("Seed panics if the generator cannot be seeded", func(t *testing.T) {
+		src := random.AsSource64(&unseedableGenerator{initTestGenerator()})
+		assert.Panics(t, func() { src.Seed(42) })
+	})
+
+	t.Run("can be used with rand.New", func(t *testing.T) {
+		r := rand.New(random.AsSource64(&unseedableGenerator{initTestGenerator()}))
+		v := r.Intn(10)
+		assert.GreaterOrEqual(t, v, 0)
+		assert.Less(t, v, 10)
+	})
+}
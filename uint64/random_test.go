@@ -2,8 +2,10 @@ package random_test
 
 import (
 	"math"
+	"math/bits"
 	"math/rand"
 	"os"
+	"runtime"
 	"testing"
 	"time"
 
@@ -25,12 +27,62 @@ func initTestGenerator() rand.Source64 {
 	return g
 }
 
+func initTestGeneratorPair() (rand.Source64, rand.Source64) {
+	testRng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	seed := testRng.Int63()
+	g1 := rand.NewSource(seed).(rand.Source64)
+	g2 := rand.NewSource(seed).(rand.Source64)
+	return g1, g2
+}
+
+type sequenceGenerator struct {
+	seq []uint64
+	pos int
+}
+
+func (g *sequenceGenerator) Uint64() uint64 {
+	v := g.seq[g.pos]
+	g.pos++
+	return v
+}
+
+// zeroPrefixGenerator returns zeros for the first n draws, and then the values of the underlying generator.
+type zeroPrefixGenerator struct {
+	g random.Generator
+	n int
+}
+
+func (g *zeroPrefixGenerator) Uint64() uint64 {
+	if g.n > 0 {
+		g.n--
+		return 0
+	}
+	return g.g.Uint64()
+}
+
 type integer interface {
-	int | int32 | int64 | uint | uint32 | uint64
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
 }
 
 type real interface {
-	float32 | float64
+	~float32 | ~float64
+}
+
+// skipArchDependentSnapshot skips a snapshot test whose values are computed by the math
+// package, which may round differently on architectures other than amd64, where the
+// snapshots are recorded.
+func skipArchDependentSnapshot(t *testing.T) {
+	if runtime.GOARCH != "amd64" {
+		t.Skip("the values depend on the implementation of the math package")
+	}
+}
+
+// skipIntSizeDependentSnapshot skips a snapshot test whose values depend on the size of
+// int on 32-bit platforms, since the snapshots are recorded on a 64-bit one.
+func skipIntSizeDependentSnapshot(t *testing.T) {
+	if bits.UintSize == 32 {
+		t.Skip("the values depend on the size of int")
+	}
 }
 
 func testSnapshot[T any](t *testing.T, generate func(g random.Generator) T) {
@@ -151,6 +203,7 @@ func testRealUniformDistribution[T real](
 
 func TestInt(t *testing.T) {
 	t.Run("snapshot", func(t *testing.T) {
+		skipIntSizeDependentSnapshot(t)
 		testSnapshot(t, random.Int)
 	})
 
@@ -181,6 +234,7 @@ func TestInt64(t *testing.T) {
 
 func TestUint(t *testing.T) {
 	t.Run("snapshot", func(t *testing.T) {
+		skipIntSizeDependentSnapshot(t)
 		testSnapshot(t, random.Uint)
 	})
 
@@ -221,6 +275,12 @@ func TestIntBetween(t *testing.T) {
 		})
 	})
 
+	t.Run("snapshot with bitmask rejection", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) int {
+			return random.IntBetween(random.WithBitmaskRejection(g), -128, 127)
+		})
+	})
+
 	t.Run("large distribution", func(t *testing.T) {
 		testLargeIntegerUniformDistribution(t, math.MinInt, math.MaxInt, func(g random.Generator) int {
 			return random.IntBetween(g, math.MinInt, math.MaxInt)
@@ -232,6 +292,12 @@ func TestIntBetween(t *testing.T) {
 			return random.IntBetween(g, -2, 5)
 		})
 	})
+
+	t.Run("small distribution with bitmask rejection", func(t *testing.T) {
+		testSmallIntegerUniformDistribution(t, -2, 5, func(g random.Generator) int {
+			return random.IntBetween(random.WithBitmaskRejection(g), -2, 5)
+		})
+	})
 }
 
 func TestInt32Between(t *testing.T) {
@@ -246,6 +312,12 @@ func TestInt32Between(t *testing.T) {
 		})
 	})
 
+	t.Run("snapshot with bitmask rejection", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) int32 {
+			return random.Int32Between(random.WithBitmaskRejection(g), -128, 127)
+		})
+	})
+
 	t.Run("large distribution", func(t *testing.T) {
 		testLargeIntegerUniformDistribution(t, math.MinInt32, math.MaxInt32, func(g random.Generator) int32 {
 			return random.Int32Between(g, math.MinInt32, math.MaxInt32)
@@ -271,6 +343,12 @@ func TestInt64Between(t *testing.T) {
 		})
 	})
 
+	t.Run("snapshot with bitmask rejection", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) int64 {
+			return random.Int64Between(random.WithBitmaskRejection(g), -128, 127)
+		})
+	})
+
 	t.Run("large distribution", func(t *testing.T) {
 		testLargeIntegerUniformDistribution(t, math.MinInt64, math.MaxInt64, func(g random.Generator) int64 {
 			return random.Int64Between(g, math.MinInt64, math.MaxInt64)
@@ -296,6 +374,12 @@ func TestUintBetween(t *testing.T) {
 		})
 	})
 
+	t.Run("snapshot with bitmask rejection", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) uint {
+			return random.UintBetween(random.WithBitmaskRejection(g), 0, 256)
+		})
+	})
+
 	t.Run("large distribution", func(t *testing.T) {
 		testLargeIntegerUniformDistribution(t, 0, math.MaxInt, func(g random.Generator) uint {
 			return random.UintBetween(g, 0, math.MaxInt)
@@ -321,6 +405,12 @@ func TestUint32Between(t *testing.T) {
 		})
 	})
 
+	t.Run("snapshot with bitmask rejection", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) uint32 {
+			return random.Uint32Between(random.WithBitmaskRejection(g), 0, 256)
+		})
+	})
+
 	t.Run("large distribution", func(t *testing.T) {
 		testLargeIntegerUniformDistribution(t, 0, math.MaxInt32, func(g random.Generator) uint32 {
 			return random.Uint32Between(g, 0, math.MaxInt32)
@@ -346,6 +436,12 @@ func TestUint64Between(t *testing.T) {
 		})
 	})
 
+	t.Run("snapshot with bitmask rejection", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) uint64 {
+			return random.Uint64Between(random.WithBitmaskRejection(g), 0, 256)
+		})
+	})
+
 	t.Run("large distribution", func(t *testing.T) {
 		testLargeIntegerUniformDistribution(t, 0, math.MaxInt64, func(g random.Generator) uint64 {
 			return random.Uint64Between(g, 0, math.MaxInt64)
@@ -359,11 +455,374 @@ func TestUint64Between(t *testing.T) {
 	})
 }
 
+func TestIntN(t *testing.T) {
+	t.Run("panics if n <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.IntN(g, 0) })
+		assert.Panics(t, func() { random.IntN(g, -1) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) int {
+			return random.IntN(g, 256)
+		})
+	})
+
+	t.Run("large distribution", func(t *testing.T) {
+		testLargeIntegerUniformDistribution(t, 0, math.MaxInt-1, func(g random.Generator) int {
+			return random.IntN(g, math.MaxInt)
+		})
+	})
+
+	t.Run("small distribution", func(t *testing.T) {
+		testSmallIntegerUniformDistribution(t, 0, 7, func(g random.Generator) int {
+			return random.IntN(g, 8)
+		})
+	})
+}
+
+func TestInt32N(t *testing.T) {
+	t.Run("panics if n <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Int32N(g, 0) })
+		assert.Panics(t, func() { random.Int32N(g, -1) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) int32 {
+			return random.Int32N(g, 256)
+		})
+	})
+
+	t.Run("large distribution", func(t *testing.T) {
+		testLargeIntegerUniformDistribution(t, 0, math.MaxInt32-1, func(g random.Generator) int32 {
+			return random.Int32N(g, math.MaxInt32)
+		})
+	})
+
+	t.Run("small distribution", func(t *testing.T) {
+		testSmallIntegerUniformDistribution(t, 0, 7, func(g random.Generator) int32 {
+			return random.Int32N(g, 8)
+		})
+	})
+}
+
+func TestInt64N(t *testing.T) {
+	t.Run("panics if n <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Int64N(g, 0) })
+		assert.Panics(t, func() { random.Int64N(g, -1) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) int64 {
+			return random.Int64N(g, 256)
+		})
+	})
+
+	t.Run("large distribution", func(t *testing.T) {
+		testLargeIntegerUniformDistribution(t, 0, math.MaxInt64-1, func(g random.Generator) int64 {
+			return random.Int64N(g, math.MaxInt64)
+		})
+	})
+
+	t.Run("small distribution", func(t *testing.T) {
+		testSmallIntegerUniformDistribution(t, 0, 7, func(g random.Generator) int64 {
+			return random.Int64N(g, 8)
+		})
+	})
+}
+
+func TestUintN(t *testing.T) {
+	t.Run("panics if n == 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.UintN(g, 0) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) uint {
+			return random.UintN(g, 256)
+		})
+	})
+
+	t.Run("large distribution", func(t *testing.T) {
+		testLargeIntegerUniformDistribution(t, 0, math.MaxUint-1, func(g random.Generator) uint {
+			return random.UintN(g, math.MaxUint)
+		})
+	})
+
+	t.Run("small distribution", func(t *testing.T) {
+		testSmallIntegerUniformDistribution(t, 0, 7, func(g random.Generator) uint {
+			return random.UintN(g, 8)
+		})
+	})
+}
+
+func TestUint32N(t *testing.T) {
+	t.Run("panics if n == 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Uint32N(g, 0) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) uint32 {
+			return random.Uint32N(g, 256)
+		})
+	})
+
+	t.Run("large distribution", func(t *testing.T) {
+		testLargeIntegerUniformDistribution(t, 0, math.MaxUint32-1, func(g random.Generator) uint32 {
+			return random.Uint32N(g, math.MaxUint32)
+		})
+	})
+
+	t.Run("small distribution", func(t *testing.T) {
+		testSmallIntegerUniformDistribution(t, 0, 7, func(g random.Generator) uint32 {
+			return random.Uint32N(g, 8)
+		})
+	})
+}
+
+func TestUint64N(t *testing.T) {
+	t.Run("panics if n == 0", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Uint64N(g, 0) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) uint64 {
+			return random.Uint64N(g, 256)
+		})
+	})
+
+	t.Run("large distribution", func(t *testing.T) {
+		testLargeIntegerUniformDistribution(t, 0, math.MaxUint64-1, func(g random.Generator) uint64 {
+			return random.Uint64N(g, math.MaxUint64)
+		})
+	})
+
+	t.Run("small distribution", func(t *testing.T) {
+		testSmallIntegerUniformDistribution(t, 0, 7, func(g random.Generator) uint64 {
+			return random.Uint64N(g, 8)
+		})
+	})
+}
+
+func TestIntBetweenErr(t *testing.T) {
+	t.Run("returns an error if min > max", func(t *testing.T) {
+		g := initTestGenerator()
+		_, err := random.IntBetweenErr(g, 128, 127)
+		assert.ErrorIs(t, err, random.ErrInvalidArgument)
+	})
+
+	t.Run("generates the same values as IntBetween", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		for i := 0; i < 100; i++ {
+			v, err := random.IntBetweenErr(g1, -2, 5)
+			assert.NoError(t, err)
+			assert.Equal(t, random.IntBetween(g2, -2, 5), v)
+		}
+	})
+}
+
+func TestInt32BetweenErr(t *testing.T) {
+	t.Run("returns an error if min > max", func(t *testing.T) {
+		g := initTestGenerator()
+		_, err := random.Int32BetweenErr(g, 128, 127)
+		assert.ErrorIs(t, err, random.ErrInvalidArgument)
+	})
+
+	t.Run("generates the same values as Int32Between", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		for i := 0; i < 100; i++ {
+			v, err := random.Int32BetweenErr(g1, -2, 5)
+			assert.NoError(t, err)
+			assert.Equal(t, random.Int32Between(g2, -2, 5), v)
+		}
+	})
+}
+
+func TestInt64BetweenErr(t *testing.T) {
+	t.Run("returns an error if min > max", func(t *testing.T) {
+		g := initTestGenerator()
+		_, err := random.Int64BetweenErr(g, 128, 127)
+		assert.ErrorIs(t, err, random.ErrInvalidArgument)
+	})
+
+	t.Run("generates the same values as Int64Between", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		for i := 0; i < 100; i++ {
+			v, err := random.Int64BetweenErr(g1, -2, 5)
+			assert.NoError(t, err)
+			assert.Equal(t, random.Int64Between(g2, -2, 5), v)
+		}
+	})
+}
+
+func TestUintBetweenErr(t *testing.T) {
+	t.Run("returns an error if min > max", func(t *testing.T) {
+		g := initTestGenerator()
+		_, err := random.UintBetweenErr(g, 128, 127)
+		assert.ErrorIs(t, err, random.ErrInvalidArgument)
+	})
+
+	t.Run("generates the same values as UintBetween", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		for i := 0; i < 100; i++ {
+			v, err := random.UintBetweenErr(g1, 2, 9)
+			assert.NoError(t, err)
+			assert.Equal(t, random.UintBetween(g2, 2, 9), v)
+		}
+	})
+}
+
+func TestUint32BetweenErr(t *testing.T) {
+	t.Run("returns an error if min > max", func(t *testing.T) {
+		g := initTestGenerator()
+		_, err := random.Uint32BetweenErr(g, 128, 127)
+		assert.ErrorIs(t, err, random.ErrInvalidArgument)
+	})
+
+	t.Run("generates the same values as Uint32Between", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		for i := 0; i < 100; i++ {
+			v, err := random.Uint32BetweenErr(g1, 2, 9)
+			assert.NoError(t, err)
+			assert.Equal(t, random.Uint32Between(g2, 2, 9), v)
+		}
+	})
+}
+
+func TestUint64BetweenErr(t *testing.T) {
+	t.Run("returns an error if min > max", func(t *testing.T) {
+		g := initTestGenerator()
+		_, err := random.Uint64BetweenErr(g, 128, 127)
+		assert.ErrorIs(t, err, random.ErrInvalidArgument)
+	})
+
+	t.Run("generates the same values as Uint64Between", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		for i := 0; i < 100; i++ {
+			v, err := random.Uint64BetweenErr(g1, 2, 9)
+			assert.NoError(t, err)
+			assert.Equal(t, random.Uint64Between(g2, 2, 9), v)
+		}
+	})
+}
+
+func TestIntNErr(t *testing.T) {
+	t.Run("returns an error if n <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		_, err := random.IntNErr(g, 0)
+		assert.ErrorIs(t, err, random.ErrInvalidArgument)
+	})
+
+	t.Run("generates the same values as IntN", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		for i := 0; i < 100; i++ {
+			v, err := random.IntNErr(g1, 8)
+			assert.NoError(t, err)
+			assert.Equal(t, random.IntN(g2, 8), v)
+		}
+	})
+}
+
+func TestInt32NErr(t *testing.T) {
+	t.Run("returns an error if n <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		_, err := random.Int32NErr(g, 0)
+		assert.ErrorIs(t, err, random.ErrInvalidArgument)
+	})
+
+	t.Run("generates the same values as Int32N", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		for i := 0; i < 100; i++ {
+			v, err := random.Int32NErr(g1, 8)
+			assert.NoError(t, err)
+			assert.Equal(t, random.Int32N(g2, 8), v)
+		}
+	})
+}
+
+func TestInt64NErr(t *testing.T) {
+	t.Run("returns an error if n <= 0", func(t *testing.T) {
+		g := initTestGenerator()
+		_, err := random.Int64NErr(g, 0)
+		assert.ErrorIs(t, err, random.ErrInvalidArgument)
+	})
+
+	t.Run("generates the same values as Int64N", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		for i := 0; i < 100; i++ {
+			v, err := random.Int64NErr(g1, 8)
+			assert.NoError(t, err)
+			assert.Equal(t, random.Int64N(g2, 8), v)
+		}
+	})
+}
+
+func TestUintNErr(t *testing.T) {
+	t.Run("returns an error if n == 0", func(t *testing.T) {
+		g := initTestGenerator()
+		_, err := random.UintNErr(g, 0)
+		assert.ErrorIs(t, err, random.ErrInvalidArgument)
+	})
+
+	t.Run("generates the same values as UintN", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		for i := 0; i < 100; i++ {
+			v, err := random.UintNErr(g1, 8)
+			assert.NoError(t, err)
+			assert.Equal(t, random.UintN(g2, 8), v)
+		}
+	})
+}
+
+func TestUint32NErr(t *testing.T) {
+	t.Run("returns an error if n == 0", func(t *testing.T) {
+		g := initTestGenerator()
+		_, err := random.Uint32NErr(g, 0)
+		assert.ErrorIs(t, err, random.ErrInvalidArgument)
+	})
+
+	t.Run("generates the same values as Uint32N", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		for i := 0; i < 100; i++ {
+			v, err := random.Uint32NErr(g1, 8)
+			assert.NoError(t, err)
+			assert.Equal(t, random.Uint32N(g2, 8), v)
+		}
+	})
+}
+
+func TestUint64NErr(t *testing.T) {
+	t.Run("returns an error if n == 0", func(t *testing.T) {
+		g := initTestGenerator()
+		_, err := random.Uint64NErr(g, 0)
+		assert.ErrorIs(t, err, random.ErrInvalidArgument)
+	})
+
+	t.Run("generates the same values as Uint64N", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		for i := 0; i < 100; i++ {
+			v, err := random.Uint64NErr(g1, 8)
+			assert.NoError(t, err)
+			assert.Equal(t, random.Uint64N(g2, 8), v)
+		}
+	})
+}
+
 func TestFloat32(t *testing.T) {
 	t.Run("snapshot", func(t *testing.T) {
 		testSnapshot(t, random.Float32)
 	})
 
+	t.Run("snapshot with bitmask rejection", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) float32 {
+			return random.Float32(random.WithBitmaskRejection(g))
+		})
+	})
+
 	t.Run("distribution", func(t *testing.T) {
 		testRealUniformDistribution(t, 0, 1.0, random.Float32)
 	})
@@ -374,11 +833,283 @@ func TestFloat64(t *testing.T) {
 		testSnapshot(t, random.Float64)
 	})
 
+	t.Run("snapshot with bitmask rejection", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.Float64(random.WithBitmaskRejection(g))
+		})
+	})
+
 	t.Run("distribution", func(t *testing.T) {
 		testRealUniformDistribution(t, 0, 1.0, random.Float64)
 	})
 }
 
+func TestFloat32Full(t *testing.T) {
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, random.Float32Full)
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		testRealUniformDistribution(t, 0, 1.0, random.Float32Full)
+	})
+
+	t.Run("distribution of tiny values", func(t *testing.T) {
+		testRealUniformDistribution(t, 0, 1.0, func(g random.Generator) float32 {
+			return random.Float32Full(&zeroPrefixGenerator{g: g, n: 1}) * (1 << 64)
+		})
+	})
+
+	t.Run("tiny values", func(t *testing.T) {
+		g := &sequenceGenerator{seq: []uint64{0, 1 << 63}}
+		assert.Equal(t, float32(math.Ldexp(1, -65)), random.Float32Full(g))
+	})
+
+	t.Run("subnormal values", func(t *testing.T) {
+		g := &sequenceGenerator{seq: []uint64{0, 0, 1 << 43}}
+		assert.Equal(t, float32(math.SmallestNonzeroFloat32), random.Float32Full(g))
+	})
+
+	t.Run("zero", func(t *testing.T) {
+		g := &sequenceGenerator{seq: []uint64{0, 0, 0}}
+		assert.Equal(t, float32(0), random.Float32Full(g))
+	})
+}
+
+func TestFloat64Full(t *testing.T) {
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, random.Float64Full)
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		testRealUniformDistribution(t, 0, 1.0, random.Float64Full)
+	})
+
+	t.Run("distribution of tiny values", func(t *testing.T) {
+		testRealUniformDistribution(t, 0, 1.0, func(g random.Generator) float64 {
+			return random.Float64Full(&zeroPrefixGenerator{g: g, n: 1}) * (1 << 64)
+		})
+	})
+
+	t.Run("tiny values", func(t *testing.T) {
+		g := &sequenceGenerator{seq: []uint64{0, 1 << 63}}
+		assert.Equal(t, math.Ldexp(1, -65), random.Float64Full(g))
+
+		g = &sequenceGenerator{seq: []uint64{3, math.MaxUint64}}
+		assert.Equal(t, math.Nextafter(math.Ldexp(1, -62), 0), random.Float64Full(g))
+	})
+
+	t.Run("subnormal values", func(t *testing.T) {
+		seq := make([]uint64, 16, 18)
+		g := &sequenceGenerator{seq: append(seq, 1<<63)}
+		assert.Equal(t, math.Ldexp(1, -1025), random.Float64Full(g))
+
+		seq = make([]uint64, 16, 18)
+		g = &sequenceGenerator{seq: append(seq, 1<<14, 0)}
+		assert.Equal(t, math.SmallestNonzeroFloat64, random.Float64Full(g))
+	})
+
+	t.Run("zero", func(t *testing.T) {
+		g := &sequenceGenerator{seq: make([]uint64, 17)}
+		assert.Equal(t, float64(0), random.Float64Full(g))
+	})
+}
+
+func TestFloat32Open(t *testing.T) {
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, random.Float32Open)
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		testRealUniformDistribution(t, 0, 1.0, random.Float32Open)
+	})
+
+	t.Run("never returns 0", func(t *testing.T) {
+		assert.Greater(t, random.Float32Open(&sequenceGenerator{seq: []uint64{0}}), float32(0))
+	})
+
+	t.Run("never returns 1", func(t *testing.T) {
+		assert.Less(t, random.Float32Open(&sequenceGenerator{seq: []uint64{math.MaxUint64}}), float32(1))
+	})
+}
+
+func TestFloat32Closed(t *testing.T) {
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, random.Float32Closed)
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		testRealUniformDistribution(t, 0, 1.0, random.Float32Closed)
+	})
+
+	t.Run("can return 0", func(t *testing.T) {
+		// 0 is rejected when generating a bounded integer, and 1 is the smallest accepted draw
+		assert.Equal(t, float32(0), random.Float32Closed(&sequenceGenerator{seq: []uint64{1}}))
+	})
+
+	t.Run("can return 1", func(t *testing.T) {
+		assert.Equal(t, float32(1), random.Float32Closed(&sequenceGenerator{seq: []uint64{math.MaxUint64}}))
+	})
+}
+
+func TestFloat32Between(t *testing.T) {
+	t.Run("panics if min > max", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Float32Between(g, 1.0, 0.5) })
+	})
+
+	t.Run("panics if min or max is not finite", func(t *testing.T) {
+		g := initTestGenerator()
+		nan := float32(math.NaN())
+		inf := float32(math.Inf(1))
+		assert.Panics(t, func() { random.Float32Between(g, nan, 1.0) })
+		assert.Panics(t, func() { random.Float32Between(g, 0.0, nan) })
+		assert.Panics(t, func() { random.Float32Between(g, -inf, 1.0) })
+		assert.Panics(t, func() { random.Float32Between(g, 0.0, inf) })
+	})
+
+	t.Run("returns min if min == max", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Equal(t, float32(1.5), random.Float32Between(g, 1.5, 1.5))
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) float32 {
+			return random.Float32Between(g, -2.5, 5.0)
+		})
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		testRealUniformDistribution(t, -2.5, 5.0, func(g random.Generator) float32 {
+			return random.Float32Between(g, -2.5, 5.0)
+		})
+	})
+
+	t.Run("distribution of the full range", func(t *testing.T) {
+		a, b := float32(-math.MaxFloat32), float32(math.MaxFloat32)
+		numBins := 8
+		testUniformDistribution(
+			t,
+			numBins,
+			func(v float32) int {
+				nv := (float64(v)/2 - float64(a)/2) / (float64(b)/2 - float64(a)/2)
+				return int(math.Floor(nv * float64(numBins)))
+			},
+			func(t *testing.T, seed int64, i int, v float32) {
+				assert.GreaterOrEqualf(t, v, a,
+					"v(%d) = %f should be greater than or equal to %f (seed = %d)", i, v, a, seed)
+				assert.Lessf(t, v, b,
+					"v(%d) = %f should be less than %f (seed = %d)", i, v, b, seed)
+			},
+			func(g random.Generator) float32 {
+				return random.Float32Between(g, a, b)
+			},
+		)
+	})
+
+	t.Run("never returns max", func(t *testing.T) {
+		max := math.Nextafter32(1, 2)
+		assert.Equal(t, float32(1), random.Float32Between(&sequenceGenerator{seq: []uint64{math.MaxUint64}}, 1, max))
+	})
+}
+
+func TestFloat64Open(t *testing.T) {
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, random.Float64Open)
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		testRealUniformDistribution(t, 0, 1.0, random.Float64Open)
+	})
+
+	t.Run("never returns 0", func(t *testing.T) {
+		assert.Greater(t, random.Float64Open(&sequenceGenerator{seq: []uint64{0}}), float64(0))
+	})
+
+	t.Run("never returns 1", func(t *testing.T) {
+		assert.Less(t, random.Float64Open(&sequenceGenerator{seq: []uint64{math.MaxUint64}}), float64(1))
+	})
+}
+
+func TestFloat64Closed(t *testing.T) {
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, random.Float64Closed)
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		testRealUniformDistribution(t, 0, 1.0, random.Float64Closed)
+	})
+
+	t.Run("can return 0", func(t *testing.T) {
+		// 0 is rejected when generating a bounded integer, and 1 is the smallest accepted draw
+		assert.Equal(t, float64(0), random.Float64Closed(&sequenceGenerator{seq: []uint64{1}}))
+	})
+
+	t.Run("can return 1", func(t *testing.T) {
+		assert.Equal(t, float64(1), random.Float64Closed(&sequenceGenerator{seq: []uint64{math.MaxUint64}}))
+	})
+}
+
+func TestFloat64Between(t *testing.T) {
+	t.Run("panics if min > max", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Panics(t, func() { random.Float64Between(g, 1.0, 0.5) })
+	})
+
+	t.Run("panics if min or max is not finite", func(t *testing.T) {
+		g := initTestGenerator()
+		nan := float64(math.NaN())
+		inf := float64(math.Inf(1))
+		assert.Panics(t, func() { random.Float64Between(g, nan, 1.0) })
+		assert.Panics(t, func() { random.Float64Between(g, 0.0, nan) })
+		assert.Panics(t, func() { random.Float64Between(g, -inf, 1.0) })
+		assert.Panics(t, func() { random.Float64Between(g, 0.0, inf) })
+	})
+
+	t.Run("returns min if min == max", func(t *testing.T) {
+		g := initTestGenerator()
+		assert.Equal(t, float64(1.5), random.Float64Between(g, 1.5, 1.5))
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		testSnapshot(t, func(g random.Generator) float64 {
+			return random.Float64Between(g, -2.5, 5.0)
+		})
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		testRealUniformDistribution(t, -2.5, 5.0, func(g random.Generator) float64 {
+			return random.Float64Between(g, -2.5, 5.0)
+		})
+	})
+
+	t.Run("distribution of the full range", func(t *testing.T) {
+		a, b := float64(-math.MaxFloat64), float64(math.MaxFloat64)
+		numBins := 8
+		testUniformDistribution(
+			t,
+			numBins,
+			func(v float64) int {
+				nv := (float64(v)/2 - float64(a)/2) / (float64(b)/2 - float64(a)/2)
+				return int(math.Floor(nv * float64(numBins)))
+			},
+			func(t *testing.T, seed int64, i int, v float64) {
+				assert.GreaterOrEqualf(t, v, a,
+					"v(%d) = %f should be greater than or equal to %f (seed = %d)", i, v, a, seed)
+				assert.Lessf(t, v, b,
+					"v(%d) = %f should be less than %f (seed = %d)", i, v, b, seed)
+			},
+			func(g random.Generator) float64 {
+				return random.Float64Between(g, a, b)
+			},
+		)
+	})
+
+	t.Run("never returns max", func(t *testing.T) {
+		max := math.Nextafter(1, 2)
+		assert.Equal(t, float64(1), random.Float64Between(&sequenceGenerator{seq: []uint64{math.MaxUint64}}, 1, max))
+	})
+}
+
 func TestBool(t *testing.T) {
 	t.Run("snapshot", func(t *testing.T) {
 		testSnapshot(t, random.Bool)
@@ -0,0 +1,16 @@
+//go:build go1.22
+
+package random
+
+import randv2 "math/rand/v2"
+
+// Generator and math/rand/v2.Source are the same interface, so any Source (e.g. rand.PCG and rand.ChaCha8) can be
+// used as a Generator, and any Generator can be used with rand.New.
+var _ Generator = (randv2.Source)(nil)
+var _ randv2.Source = (Generator)(nil)
+
+// AsRandSource returns g as a math/rand/v2.Source.
+// It is the identity function, provided so that code can switch between the uint32 and uint64 versions.
+func AsRandSource(g Generator) randv2.Source {
+	return g
+}
@@ -0,0 +1,45 @@
+//go:build go1.22
+
+package random_test
+
+import (
+	randv2 "math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint64"
+)
+
+func TestRandV2Source(t *testing.T) {
+	t.Run("Source can be used as Generator", func(t *testing.T) {
+		var g random.Generator = randv2.NewPCG(1, 2)
+		src := randv2.NewPCG(1, 2)
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, src.Uint64(), random.Uint64(g))
+		}
+	})
+
+	t.Run("Generator can be used with rand.New", func(t *testing.T) {
+		r := randv2.New(initTestGenerator())
+		v := r.IntN(10)
+		assert.GreaterOrEqual(t, v, 0)
+		assert.Less(t, v, 10)
+	})
+}
+
+func TestAsRandSource(t *testing.T) {
+	t.Run("yields the values of Uint64", func(t *testing.T) {
+		src := random.AsRandSource(randv2.NewPCG(1, 2))
+		g := randv2.NewPCG(1, 2)
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, g.Uint64(), src.Uint64())
+		}
+	})
+
+	t.Run("can be used with rand.New", func(t *testing.T) {
+		r := randv2.New(random.AsRandSource(initTestGenerator()))
+		v := r.IntN(10)
+		assert.GreaterOrEqual(t, v, 0)
+		assert.Less(t, v, 10)
+	})
+}
@@ -0,0 +1,20 @@
+//go:build go1.23
+
+package random
+
+import "iter"
+
+// AddSeq adds all the items of seq.
+func (r *Reservoir[T]) AddSeq(seq iter.Seq[T]) {
+	for item := range seq {
+		r.Add(item)
+	}
+}
+
+// AddSeq adds all the pairs of an item and its weight of seq.
+// It panics if a weight is negative or not finite.
+func (r *WeightedReservoir[T]) AddSeq(seq iter.Seq2[T, float64]) {
+	for item, weight := range seq {
+		r.Add(item, weight)
+	}
+}
@@ -0,0 +1,49 @@
+//go:build go1.23
+
+package random_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint64"
+)
+
+func TestReservoirAddSeq(t *testing.T) {
+	t.Run("samples the same items as Add", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		r1 := random.NewReservoir[int](g1, 10)
+		r2 := random.NewReservoir[int](g2, 10)
+		s := identity(1000)
+		r1.AddSeq(slices.Values(s))
+		for _, v := range s {
+			r2.Add(v)
+		}
+		assert.Equal(t, r2.Items(), r1.Items())
+		assert.Equal(t, r2.Count(), r1.Count())
+	})
+}
+
+func TestWeightedReservoirAddSeq(t *testing.T) {
+	t.Run("samples the same items as Add", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		r1 := random.NewWeightedReservoir[string](g1, 2)
+		r2 := random.NewWeightedReservoir[string](g2, 2)
+		weights := map[string]float64{"a": 1, "b": 2, "c": 3, "d": 4}
+		keys := slices.Sorted(maps.Keys(weights))
+		r1.AddSeq(func(yield func(string, float64) bool) {
+			for _, k := range keys {
+				if !yield(k, weights[k]) {
+					return
+				}
+			}
+		})
+		for _, k := range keys {
+			r2.Add(k, weights[k])
+		}
+		assert.Equal(t, r2.Items(), r1.Items())
+		assert.Equal(t, r2.Count(), r1.Count())
+	})
+}
@@ -0,0 +1,45 @@
+package random
+
+import "math"
+
+// Shuffle randomly permutes the elements of s in place, using the Fisher–Yates algorithm.
+// All permutations are equally likely.
+//
+// It generates the same permutation regardless of the size of int on the platform,
+// so that it can be reproduced anywhere with the same generator.
+func Shuffle[T any](g Generator, s []T) {
+	PartialShuffle(g, s, len(s))
+}
+
+// PartialShuffle randomly selects k elements of s and moves them to the first k positions in random order,
+// using the first k steps of the Fisher–Yates algorithm.
+// The rest of s is left in an unspecified order.
+// It panics if k < 0 or k > len(s) is given.
+//
+// PartialShuffle(g, s, len(s)) is equivalent to Shuffle(g, s), and for any k, the first k elements are the same as
+// the ones that Shuffle would place there with the same generator.
+func PartialShuffle[T any](g Generator, s []T, k int) {
+	if k < 0 || k > len(s) {
+		panic("invalid argument to PartialShuffle: k must be within the range [0, len(s)]")
+	}
+	n := len(s)
+	if k == n && n > 0 {
+		// the last step always swaps the last element with itself
+		k = n - 1
+	}
+	for i := 0; i < k; i++ {
+		j := i + indexAtMost(g, n-1-i)
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// indexAtMost returns a random int value within the range [0, max].
+// Unlike uintAtMost, it draws a uint32 value whenever max fits in it, so that the result does not depend on the size
+// of int.
+func indexAtMost(g Generator, max int) int {
+	if uint64(max) <= math.MaxUint32 {
+		return int(uint32AtMost(g, uint32(max)))
+	} else {
+		return int(uint64AtMost(g, uint64(max)))
+	}
+}
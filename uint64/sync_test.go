@@ -0,0 +1,118 @@
+package random_test
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	random "github.com/susisu/go-random/uint64"
+)
+
+type counterGenerator struct {
+	n uint64
+}
+
+func (g *counterGenerator) Uint64() uint64 {
+	v := g.n
+	g.n++
+	return v
+}
+
+func drawConcurrently(g random.Generator, numGoroutines, numDraws int) []uint64 {
+	results := make([][]uint64, numGoroutines)
+	var wg sync.WaitGroup
+	for i := 0; i < numGoroutines; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < numDraws; j++ {
+				results[i] = append(results[i], random.Uint64(g))
+			}
+		}()
+	}
+	wg.Wait()
+
+	vs := make([]uint64, 0, numGoroutines*numDraws)
+	for _, r := range results {
+		vs = append(vs, r...)
+	}
+	return vs
+}
+
+func TestLockedGenerator(t *testing.T) {
+	t.Run("can be used from multiple goroutines", func(t *testing.T) {
+		g := random.NewLockedGenerator(&counterGenerator{})
+		vs := drawConcurrently(g, 8, 1000)
+
+		seen := make(map[uint64]bool)
+		for _, v := range vs {
+			assert.Falsef(t, seen[v], "value %d should be yielded only once", v)
+			seen[v] = true
+		}
+		assert.Equal(t, uint64(8*1000), g.Uint64())
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		testLargeIntegerUniformDistribution(t, 0, math.MaxUint64, func(g random.Generator) uint64 {
+			return random.NewLockedGenerator(g).Uint64()
+		})
+	})
+}
+
+func TestLockedGeneratorBitmaskRejection(t *testing.T) {
+	t.Run("keeps the bitmask rejection mode", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		l := random.NewLockedGenerator(random.WithBitmaskRejection(g1))
+		b := random.WithBitmaskRejection(g2)
+		for i := 0; i < 100; i++ {
+			assert.Equal(t, random.IntBetween(b, 0, 100), random.IntBetween(l, 0, 100))
+			assert.Equal(t, random.Uint64Between(b, 0, 100), random.Uint64Between(l, 0, 100))
+		}
+	})
+}
+
+func TestShardedGenerator(t *testing.T) {
+	t.Run("can be used from multiple goroutines", func(t *testing.T) {
+		var mu sync.Mutex
+		indices := make(map[uint64]bool)
+		g := random.NewShardedGenerator(func(i uint64) random.Generator {
+			mu.Lock()
+			defer mu.Unlock()
+			assert.Falsef(t, indices[i], "index %d should be used only once", i)
+			indices[i] = true
+			// shards are not synchronized, so the race detector reports if a shard is used concurrently
+			return &counterGenerator{n: i << 32}
+		})
+		vs := drawConcurrently(g, 8, 1000)
+
+		seen := make(map[uint64]bool)
+		for _, v := range vs {
+			assert.Falsef(t, seen[v], "value %d should be yielded only once", v)
+			seen[v] = true
+		}
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		var seed int64 = 0x5eed // fixed so that failures are reproducible
+		g := random.NewShardedGenerator(func(i uint64) random.Generator {
+			return rand.NewSource(seed + int64(i)).(rand.Source64)
+		})
+		testLargeIntegerUniformDistribution(t, 0, math.MaxUint64, func(_ random.Generator) uint64 {
+			return g.Uint64()
+		})
+	})
+
+	t.Run("does not keep the bitmask rejection mode of the shards", func(t *testing.T) {
+		g1, g2 := initTestGeneratorPair()
+		// all the shards draw from g1, so that the values do not depend on which shard is used
+		g := random.NewShardedGenerator(func(i uint64) random.Generator {
+			return random.WithBitmaskRejection(g1)
+		})
+		for i := 0; i < 100; i++ {
+			assert.Equal(t, random.IntBetween(g2, 0, 100), random.IntBetween(g, 0, 100))
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package xoshiro
+
+import (
+	"math/bits"
+
+	random32 "github.com/susisu/go-random/uint32"
+	random64 "github.com/susisu/go-random/uint64"
+)
+
+// jump2 applies a jump polynomial to a two-word state, using step to advance it.
+func jump2(s *[2]uint64, poly *[2]uint64, step func()) {
+	var t [2]uint64
+	for _, p := range poly {
+		for b := 0; b < 64; b++ {
+			if p&(1<<b) != 0 {
+				t[0] ^= s[0]
+				t[1] ^= s[1]
+			}
+			step()
+		}
+	}
+	*s = t
+}
+
+var (
+	jumpPlus     = [2]uint64{0xdf900294d8f554a5, 0x170865df4b3201fc}
+	longJumpPlus = [2]uint64{0xd2a98b26625eee7b, 0xdddf9b1090aa7ac1}
+)
+
+// Xoroshiro128Plus is the xoroshiro128+ generator, with 128-bit state and 64-bit output.
+// The lowest bits of its output have low linear complexity; prefer Xoroshiro128PlusPlus unless only the upper bits
+// are used (e.g. for floating-point numbers).
+type Xoroshiro128Plus struct {
+	s [2]uint64
+}
+
+var _ random32.Generator = (*Xoroshiro128Plus)(nil)
+var _ random64.Generator = (*Xoroshiro128Plus)(nil)
+
+// NewXoroshiro128Plus creates a new xoroshiro128+ generator with the given initial state.
+// It panics if the state is all zero.
+func NewXoroshiro128Plus(state [2]uint64) *Xoroshiro128Plus {
+	if state == ([2]uint64{}) {
+		panic("invalid argument to NewXoroshiro128Plus: state must not be all zero")
+	}
+	return &Xoroshiro128Plus{state}
+}
+
+func (x *Xoroshiro128Plus) step() {
+	s0, s1 := x.s[0], x.s[1]
+	s1 ^= s0
+	x.s[0] = bits.RotateLeft64(s0, 24) ^ s1 ^ (s1 << 16)
+	x.s[1] = bits.RotateLeft64(s1, 37)
+}
+
+// Uint64 returns a random uint64 value.
+func (x *Xoroshiro128Plus) Uint64() uint64 {
+	v := x.s[0] + x.s[1]
+	x.step()
+	return v
+}
+
+// Uint32 returns a random uint32 value taken from the upper half of a 64-bit output.
+func (x *Xoroshiro128Plus) Uint32() uint32 {
+	return uint32(x.Uint64() >> 32)
+}
+
+// Jump advances the generator by 2^64 steps.
+// It can be used to generate 2^64 non-overlapping subsequences for parallel computations.
+func (x *Xoroshiro128Plus) Jump() {
+	jump2(&x.s, &jumpPlus, x.step)
+}
+
+// LongJump advances the generator by 2^96 steps.
+// It can be used to generate 2^32 starting points, from each of which Jump will generate 2^32 non-overlapping
+// subsequences.
+func (x *Xoroshiro128Plus) LongJump() {
+	jump2(&x.s, &longJumpPlus, x.step)
+}
+
+var (
+	jumpPlusPlus     = [2]uint64{0x2bd7a6a6e99c2ddc, 0x0992ccaf6a6fca05}
+	longJumpPlusPlus = [2]uint64{0x360fd5f2cf8d5d99, 0x9c6e6877736c46e3}
+)
+
+// Xoroshiro128PlusPlus is the xoroshiro128++ generator, with 128-bit state and 64-bit output.
+type Xoroshiro128PlusPlus struct {
+	s [2]uint64
+}
+
+var _ random32.Generator = (*Xoroshiro128PlusPlus)(nil)
+var _ random64.Generator = (*Xoroshiro128PlusPlus)(nil)
+
+// NewXoroshiro128PlusPlus creates a new xoroshiro128++ generator with the given initial state.
+// It panics if the state is all zero.
+func NewXoroshiro128PlusPlus(state [2]uint64) *Xoroshiro128PlusPlus {
+	if state == ([2]uint64{}) {
+		panic("invalid argument to NewXoroshiro128PlusPlus: state must not be all zero")
+	}
+	return &Xoroshiro128PlusPlus{state}
+}
+
+func (x *Xoroshiro128PlusPlus) step() {
+	s0, s1 := x.s[0], x.s[1]
+	s1 ^= s0
+	x.s[0] = bits.RotateLeft64(s0, 49) ^ s1 ^ (s1 << 21)
+	x.s[1] = bits.RotateLeft64(s1, 28)
+}
+
+// Uint64 returns a random uint64 value.
+func (x *Xoroshiro128PlusPlus) Uint64() uint64 {
+	v := bits.RotateLeft64(x.s[0]+x.s[1], 17) + x.s[0]
+	x.step()
+	return v
+}
+
+// Uint32 returns a random uint32 value taken from the upper half of a 64-bit output.
+func (x *Xoroshiro128PlusPlus) Uint32() uint32 {
+	return uint32(x.Uint64() >> 32)
+}
+
+// Jump advances the generator by 2^64 steps.
+// It can be used to generate 2^64 non-overlapping subsequences for parallel computations.
+func (x *Xoroshiro128PlusPlus) Jump() {
+	jump2(&x.s, &jumpPlusPlus, x.step)
+}
+
+// LongJump advances the generator by 2^96 steps.
+// It can be used to generate 2^32 starting points, from each of which Jump will generate 2^32 non-overlapping
+// subsequences.
+func (x *Xoroshiro128PlusPlus) LongJump() {
+	jump2(&x.s, &longJumpPlusPlus, x.step)
+}
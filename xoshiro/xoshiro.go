@@ -0,0 +1,209 @@
+// Package xoshiro provides generators of the xoshiro / xoroshiro family.
+// See https://prng.di.unimi.it/ for details of the algorithms.
+//
+// The generators must be initialized with states that are not all zero.
+// splitmix.Expand can be used to initialize the states from a single seed.
+package xoshiro
+
+import (
+	"math/bits"
+
+	random32 "github.com/susisu/go-random/uint32"
+	random64 "github.com/susisu/go-random/uint64"
+)
+
+// state256 is the state of the xoshiro256 generators.
+type state256 [4]uint64
+
+func (s *state256) step() {
+	t := s[1] << 17
+	s[2] ^= s[0]
+	s[3] ^= s[1]
+	s[1] ^= s[2]
+	s[0] ^= s[3]
+	s[2] ^= t
+	s[3] = bits.RotateLeft64(s[3], 45)
+}
+
+var (
+	jump256     = [4]uint64{0x180ec6d33cfd0aba, 0xd5a61266f0c9392c, 0xa9582618e03fc9aa, 0x39abdc4529b1661c}
+	longJump256 = [4]uint64{0x76e15d3efefdcbbf, 0xc5004e441c522fb3, 0x77710069854ee241, 0x39109bb02acbe635}
+)
+
+func (s *state256) jump(poly *[4]uint64) {
+	var t state256
+	for _, p := range poly {
+		for b := 0; b < 64; b++ {
+			if p&(1<<b) != 0 {
+				t[0] ^= s[0]
+				t[1] ^= s[1]
+				t[2] ^= s[2]
+				t[3] ^= s[3]
+			}
+			s.step()
+		}
+	}
+	*s = t
+}
+
+// Xoshiro256StarStar is the xoshiro256** generator, with 256-bit state and 64-bit output.
+type Xoshiro256StarStar struct {
+	s state256
+}
+
+var _ random32.Generator = (*Xoshiro256StarStar)(nil)
+var _ random64.Generator = (*Xoshiro256StarStar)(nil)
+
+// NewXoshiro256StarStar creates a new xoshiro256** generator with the given initial state.
+// It panics if the state is all zero.
+func NewXoshiro256StarStar(state [4]uint64) *Xoshiro256StarStar {
+	if state == ([4]uint64{}) {
+		panic("invalid argument to NewXoshiro256StarStar: state must not be all zero")
+	}
+	return &Xoshiro256StarStar{state256(state)}
+}
+
+// Uint64 returns a random uint64 value.
+func (x *Xoshiro256StarStar) Uint64() uint64 {
+	v := bits.RotateLeft64(x.s[1]*5, 7) * 9
+	x.s.step()
+	return v
+}
+
+// Uint32 returns a random uint32 value taken from the upper half of a 64-bit output.
+func (x *Xoshiro256StarStar) Uint32() uint32 {
+	return uint32(x.Uint64() >> 32)
+}
+
+// Jump advances the generator by 2^128 steps.
+// It can be used to generate 2^128 non-overlapping subsequences for parallel computations.
+func (x *Xoshiro256StarStar) Jump() {
+	x.s.jump(&jump256)
+}
+
+// LongJump advances the generator by 2^192 steps.
+// It can be used to generate 2^64 starting points, from each of which Jump will generate 2^64 non-overlapping
+// subsequences.
+func (x *Xoshiro256StarStar) LongJump() {
+	x.s.jump(&longJump256)
+}
+
+// Xoshiro256PlusPlus is the xoshiro256++ generator, with 256-bit state and 64-bit output.
+type Xoshiro256PlusPlus struct {
+	s state256
+}
+
+var _ random32.Generator = (*Xoshiro256PlusPlus)(nil)
+var _ random64.Generator = (*Xoshiro256PlusPlus)(nil)
+
+// NewXoshiro256PlusPlus creates a new xoshiro256++ generator with the given initial state.
+// It panics if the state is all zero.
+func NewXoshiro256PlusPlus(state [4]uint64) *Xoshiro256PlusPlus {
+	if state == ([4]uint64{}) {
+		panic("invalid argument to NewXoshiro256PlusPlus: state must not be all zero")
+	}
+	return &Xoshiro256PlusPlus{state256(state)}
+}
+
+// Uint64 returns a random uint64 value.
+func (x *Xoshiro256PlusPlus) Uint64() uint64 {
+	v := bits.RotateLeft64(x.s[0]+x.s[3], 23) + x.s[0]
+	x.s.step()
+	return v
+}
+
+// Uint32 returns a random uint32 value taken from the upper half of a 64-bit output.
+func (x *Xoshiro256PlusPlus) Uint32() uint32 {
+	return uint32(x.Uint64() >> 32)
+}
+
+// Jump advances the generator by 2^128 steps.
+// It can be used to generate 2^128 non-overlapping subsequences for parallel computations.
+func (x *Xoshiro256PlusPlus) Jump() {
+	x.s.jump(&jump256)
+}
+
+// LongJump advances the generator by 2^192 steps.
+// It can be used to generate 2^64 starting points, from each of which Jump will generate 2^64 non-overlapping
+// subsequences.
+func (x *Xoshiro256PlusPlus) LongJump() {
+	x.s.jump(&longJump256)
+}
+
+// state128 is the state of the xoshiro128 generators.
+type state128 [4]uint32
+
+func (s *state128) step() {
+	t := s[1] << 9
+	s[2] ^= s[0]
+	s[3] ^= s[1]
+	s[1] ^= s[2]
+	s[0] ^= s[3]
+	s[2] ^= t
+	s[3] = bits.RotateLeft32(s[3], 11)
+}
+
+var (
+	jump128     = [4]uint32{0x8764000b, 0xf542d2d3, 0x6fa035c3, 0x77f2db5b}
+	longJump128 = [4]uint32{0xb523952e, 0x0b6f099f, 0xccf5a0ef, 0x1c580662}
+)
+
+func (s *state128) jump(poly *[4]uint32) {
+	var t state128
+	for _, p := range poly {
+		for b := 0; b < 32; b++ {
+			if p&(1<<b) != 0 {
+				t[0] ^= s[0]
+				t[1] ^= s[1]
+				t[2] ^= s[2]
+				t[3] ^= s[3]
+			}
+			s.step()
+		}
+	}
+	*s = t
+}
+
+// Xoshiro128StarStar is the xoshiro128** generator, with 128-bit state and 32-bit output.
+type Xoshiro128StarStar struct {
+	s state128
+}
+
+var _ random32.Generator = (*Xoshiro128StarStar)(nil)
+var _ random64.Generator = (*Xoshiro128StarStar)(nil)
+
+// NewXoshiro128StarStar creates a new xoshiro128** generator with the given initial state.
+// It panics if the state is all zero.
+func NewXoshiro128StarStar(state [4]uint32) *Xoshiro128StarStar {
+	if state == ([4]uint32{}) {
+		panic("invalid argument to NewXoshiro128StarStar: state must not be all zero")
+	}
+	return &Xoshiro128StarStar{state128(state)}
+}
+
+// Uint32 returns a random uint32 value.
+func (x *Xoshiro128StarStar) Uint32() uint32 {
+	v := bits.RotateLeft32(x.s[1]*5, 7) * 9
+	x.s.step()
+	return v
+}
+
+// Uint64 returns a random uint64 value by combining two outputs, the lower half first.
+func (x *Xoshiro128StarStar) Uint64() uint64 {
+	lo := uint64(x.Uint32())
+	hi := uint64(x.Uint32())
+	return (hi << 32) | lo
+}
+
+// Jump advances the generator by 2^64 steps.
+// It can be used to generate 2^64 non-overlapping subsequences for parallel computations.
+func (x *Xoshiro128StarStar) Jump() {
+	x.s.jump(&jump128)
+}
+
+// LongJump advances the generator by 2^96 steps.
+// It can be used to generate 2^32 starting points, from each of which Jump will generate 2^32 non-overlapping
+// subsequences.
+func (x *Xoshiro128StarStar) LongJump() {
+	x.s.jump(&longJump128)
+}
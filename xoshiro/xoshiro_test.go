@@ -0,0 +1,212 @@
+package xoshiro_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+	"github.com/susisu/go-random/xoshiro"
+)
+
+func TestMain(t *testing.M) {
+	v := t.Run()
+	snaps.Clean(t)
+	os.Exit(v)
+}
+
+func testSnapshot[T any](t *testing.T, generate func() T) {
+	numSamples := 100
+
+	seq := make([]T, 0, numSamples)
+	for i := 0; i < numSamples; i++ {
+		seq = append(seq, generate())
+	}
+
+	snaps.MatchSnapshot(t, seq)
+}
+
+func testReference[T comparable](t *testing.T, generate func() T, expected []T) {
+	for i, e := range expected {
+		assert.Equalf(t, e, generate(), "output %d", i)
+	}
+}
+
+// Reference outputs are generated by the reference C implementations, with initial states {1, 2, 3, 4} or {1, 2}.
+
+func TestXoshiro256StarStar(t *testing.T) {
+	t.Run("panics if state is all zero", func(t *testing.T) {
+		assert.Panics(t, func() { xoshiro.NewXoshiro256StarStar([4]uint64{}) })
+	})
+
+	t.Run("reference", func(t *testing.T) {
+		g := xoshiro.NewXoshiro256StarStar([4]uint64{1, 2, 3, 4})
+		testReference(t, g.Uint64, []uint64{
+			0x0000000000002d00, 0x0000000000000000, 0x000000005a007080, 0x10e0000000009d80,
+			0x10e0b61ce1009d80, 0x0870021ce143ad00, 0xe071c3c2e143f089, 0x75a1690ef7a20380,
+		})
+	})
+
+	t.Run("Jump", func(t *testing.T) {
+		g := xoshiro.NewXoshiro256StarStar([4]uint64{1, 2, 3, 4})
+		g.Jump()
+		testReference(t, g.Uint64, []uint64{
+			0xbbd2f312298443d8, 0x62e57db2d5706577, 0x34d1890374a6d72b, 0xa0425028ca8b66a0,
+		})
+	})
+
+	t.Run("LongJump", func(t *testing.T) {
+		g := xoshiro.NewXoshiro256StarStar([4]uint64{1, 2, 3, 4})
+		g.LongJump()
+		testReference(t, g.Uint64, []uint64{
+			0x527752a1d792704d, 0xd8d8bdec57599e64, 0x601cb926727eb003, 0xe0cd980a84253102,
+		})
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		g := xoshiro.NewXoshiro256StarStar([4]uint64{0xc0ffee, 1, 2, 3})
+		testSnapshot(t, g.Uint64)
+	})
+}
+
+func TestXoshiro256PlusPlus(t *testing.T) {
+	t.Run("panics if state is all zero", func(t *testing.T) {
+		assert.Panics(t, func() { xoshiro.NewXoshiro256PlusPlus([4]uint64{}) })
+	})
+
+	t.Run("reference", func(t *testing.T) {
+		g := xoshiro.NewXoshiro256PlusPlus([4]uint64{1, 2, 3, 4})
+		testReference(t, g.Uint64, []uint64{
+			0x0000000002800001, 0x0000000003800067, 0x000cc00003800067, 0x000cc201994400b2,
+			0x8012a2019ac433cd, 0x8a69978acdee33ba, 0xc271134733154abd, 0xac2ba09179169e97,
+		})
+	})
+
+	t.Run("Jump", func(t *testing.T) {
+		g := xoshiro.NewXoshiro256PlusPlus([4]uint64{1, 2, 3, 4})
+		g.Jump()
+		testReference(t, g.Uint64, []uint64{
+			0xec879073673df437, 0x20d212a39aca1eaa, 0xc19d712a27e40f57, 0x6ff0e08dc71026a1,
+		})
+	})
+
+	t.Run("LongJump", func(t *testing.T) {
+		g := xoshiro.NewXoshiro256PlusPlus([4]uint64{1, 2, 3, 4})
+		g.LongJump()
+		testReference(t, g.Uint64, []uint64{
+			0xb5c4ea370b330bf5, 0x5173cc693c0fa533, 0x1dc5df0151f7b491, 0xe7b055cfeabc4661,
+		})
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		g := xoshiro.NewXoshiro256PlusPlus([4]uint64{0xc0ffee, 1, 2, 3})
+		testSnapshot(t, g.Uint64)
+	})
+}
+
+func TestXoshiro128StarStar(t *testing.T) {
+	t.Run("panics if state is all zero", func(t *testing.T) {
+		assert.Panics(t, func() { xoshiro.NewXoshiro128StarStar([4]uint32{}) })
+	})
+
+	t.Run("reference", func(t *testing.T) {
+		g := xoshiro.NewXoshiro128StarStar([4]uint32{1, 2, 3, 4})
+		testReference(t, g.Uint32, []uint32{
+			0x00002d00, 0x00000000, 0x005a7080, 0x04389d80, 0x79199d9b, 0x61963b24, 0x4cb9b57a, 0xde9d7431,
+		})
+	})
+
+	t.Run("Uint64 combines two outputs", func(t *testing.T) {
+		g := xoshiro.NewXoshiro128StarStar([4]uint32{1, 2, 3, 4})
+		testReference(t, g.Uint64, []uint64{
+			0x0000000000002d00, 0x04389d80005a7080, 0x61963b2479199d9b, 0xde9d74314cb9b57a,
+		})
+	})
+
+	t.Run("Jump", func(t *testing.T) {
+		g := xoshiro.NewXoshiro128StarStar([4]uint32{1, 2, 3, 4})
+		g.Jump()
+		testReference(t, g.Uint32, []uint32{0x472fa5a7, 0x2c705cbc, 0x0189f94c, 0xc5ea7935})
+	})
+
+	t.Run("LongJump", func(t *testing.T) {
+		g := xoshiro.NewXoshiro128StarStar([4]uint32{1, 2, 3, 4})
+		g.LongJump()
+		testReference(t, g.Uint32, []uint32{0xf74b371c, 0x0398bbf2, 0xd8e66664, 0xae829f35})
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		g := xoshiro.NewXoshiro128StarStar([4]uint32{0xc0ffee, 1, 2, 3})
+		testSnapshot(t, g.Uint32)
+	})
+}
+
+func TestXoroshiro128Plus(t *testing.T) {
+	t.Run("panics if state is all zero", func(t *testing.T) {
+		assert.Panics(t, func() { xoshiro.NewXoroshiro128Plus([2]uint64{}) })
+	})
+
+	t.Run("reference", func(t *testing.T) {
+		g := xoshiro.NewXoroshiro128Plus([2]uint64{1, 2})
+		testReference(t, g.Uint64, []uint64{
+			0x0000000000000003, 0x0000006001030003, 0x20c102c302000c03, 0x810180670d23ad61,
+			0x26d13a4941333a42, 0x538a501c02f58b2e, 0x2ab2076dee382f7e, 0x30dfcfb722fecd9c,
+		})
+	})
+
+	t.Run("Jump", func(t *testing.T) {
+		g := xoshiro.NewXoroshiro128Plus([2]uint64{1, 2})
+		g.Jump()
+		testReference(t, g.Uint64, []uint64{
+			0xea081299d29ad927, 0xdde2899549f899c8, 0xe9fbdbe2a1bfda9c, 0x2d3a2ecac8b96cc2,
+		})
+	})
+
+	t.Run("LongJump", func(t *testing.T) {
+		g := xoshiro.NewXoroshiro128Plus([2]uint64{1, 2})
+		g.LongJump()
+		testReference(t, g.Uint64, []uint64{
+			0x6786a13daa9b187d, 0xe6c8f691b4e837bd, 0xecdbe155055ea35e, 0x546e33bf4c9648d6,
+		})
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		g := xoshiro.NewXoroshiro128Plus([2]uint64{0xc0ffee, 1})
+		testSnapshot(t, g.Uint64)
+	})
+}
+
+func TestXoroshiro128PlusPlus(t *testing.T) {
+	t.Run("panics if state is all zero", func(t *testing.T) {
+		assert.Panics(t, func() { xoshiro.NewXoroshiro128PlusPlus([2]uint64{}) })
+	})
+
+	t.Run("reference", func(t *testing.T) {
+		g := xoshiro.NewXoroshiro128PlusPlus([2]uint64{1, 2})
+		testReference(t, g.Uint64, []uint64{
+			0x0000000000060001, 0x000260c000660007, 0x180acc04718606d3, 0x9e226d35036fc4c7,
+			0x849bc9ac6b960be4, 0x31c5870fc130361b, 0x17790d7cd5b2e061, 0x94fc9bb11da24a91,
+		})
+	})
+
+	t.Run("Jump", func(t *testing.T) {
+		g := xoshiro.NewXoroshiro128PlusPlus([2]uint64{1, 2})
+		g.Jump()
+		testReference(t, g.Uint64, []uint64{
+			0x6115ff4c07d8c03e, 0xf4564a51c7eab4b9, 0xfd85cda8113be346, 0x16ad915520f57cdd,
+		})
+	})
+
+	t.Run("LongJump", func(t *testing.T) {
+		g := xoshiro.NewXoroshiro128PlusPlus([2]uint64{1, 2})
+		g.LongJump()
+		testReference(t, g.Uint64, []uint64{
+			0xbb077da55888837c, 0x3fd58ef899113160, 0x851ed84070f6f99c, 0xe38daa293a42cb2d,
+		})
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		g := xoshiro.NewXoroshiro128PlusPlus([2]uint64{0xc0ffee, 1})
+		testSnapshot(t, g.Uint64)
+	})
+}